@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"event-driven-go/internal/domains/movies"
+	"event-driven-go/internal/domains/people"
+	"event-driven-go/internal/shared"
+)
+
+const dedupeUsage = "usage: main dedupe [-merge]"
+
+// runDedupeCommand implements `dedupe`, which lists the movies that look like
+// duplicates and with -merge merges each group into its first movie,
+// returning the process exit code.
+func runDedupeCommand(args []string, logger *slog.Logger) int {
+	flags := flag.NewFlagSet("dedupe", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), dedupeUsage)
+		flags.PrintDefaults()
+	}
+	merge := flags.Bool("merge", false, "merge every group into its first movie")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 0 {
+		flags.Usage()
+		return 2
+	}
+
+	dbConnections, err := shared.NewDatabaseConnections(context.Background(), logger)
+	if err != nil {
+		logger.Error("failed to connect to databases", slog.Any("error", err))
+		return 1
+	}
+	defer func(dbConnections *shared.DatabaseConnections) {
+		if err := dbConnections.Close(); err != nil {
+			logger.Error("failed to close database connections", slog.Any("error", err))
+		}
+	}(dbConnections)
+
+	embedder, err := movies.NewEmbedder(shared.Config.Embeddings)
+	if err != nil {
+		logger.Error("failed to create embedder", slog.Any("error", err))
+		return 1
+	}
+
+	movieRepo := movies.NewMongoRepository(dbConnections.MongoDB, logger)
+	movieService := movies.NewService(movieRepo, embedder, nil, newMovieUnitOfWork(dbConnections.PostgreSQL, people.NewRepository(dbConnections.MongoDB, logger), logger), shared.GlobalEventBus, logger)
+
+	ctx := shared.ContextWithPrincipal(context.Background(), shared.SystemPrincipal("dedupe"))
+	groups, err := movieService.FindDuplicates(ctx)
+	if err != nil {
+		logger.Error("failed to find duplicate movies", slog.Any("error", err))
+		return 1
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "GROUP\tID\tTITLE\tRELEASED\tRUNTIME\tIMDB\tTMDB\tWIKIDATA\tADDED")
+	for i, group := range groups {
+		for _, movie := range group.Movies {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%d\t%s\t%d\t%s\t%s\n", i+1, movie.ID.Hex(), movie.Title, movie.ReleaseDate,
+				movie.Runtime, movie.IMDb, movie.TMDb, movie.Wikidata, movie.CreatedAt.Format(time.DateOnly))
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return 1
+	}
+	if !*merge {
+		fmt.Printf("%d groups of duplicates, run with -merge to merge them\n", len(groups))
+		return 0
+	}
+
+	failed := 0
+	for _, group := range groups {
+		survivor := group.Movies[0].ID.Hex()
+		duplicateIDs := make([]string, 0, len(group.Movies)-1)
+		for _, movie := range group.Movies[1:] {
+			duplicateIDs = append(duplicateIDs, movie.ID.Hex())
+		}
+
+		if _, err := movieService.MergeMovies(ctx, survivor, duplicateIDs); err != nil {
+			logger.Error("failed to merge movies", slog.String("movie_id", survivor), slog.Any("error", err))
+			failed++
+		}
+	}
+	fmt.Printf("merged %d of %d groups\n", len(groups)-failed, len(groups))
+
+	if failed > 0 {
+		return 1
+	}
+	return 0
+}
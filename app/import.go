@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"event-driven-go/internal/domains/movies"
+	"event-driven-go/internal/shared"
+)
+
+const importUsage = "usage: main import [flags] <csv|jsonl|imdb> <file>"
+
+// runImportCommand implements `import`, which bulk loads movies from a file,
+// returning the process exit code. An interrupted import with a progress
+// file resumes when run again with the same arguments.
+func runImportCommand(args []string, logger *slog.Logger) int {
+	flags := flag.NewFlagSet("import", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), importUsage)
+		flags.PrintDefaults()
+	}
+
+	var options movies.ImportOptions
+	mapping := flags.String("map", "", "comma separated field=column pairs for columns not named after movie fields (csv, jsonl)")
+	flags.StringVar(&options.Principals, "principals", "", "IMDb title.principals file to take directors from (imdb, needs -names)")
+	flags.StringVar(&options.Names, "names", "", "IMDb name.basics file to look up director names in (imdb)")
+	flags.IntVar(&options.BatchSize, "batch", movies.DefaultImportBatchSize, "movies written between progress checkpoints")
+	flags.StringVar(&options.Progress, "progress", "", "file to record progress in, resumed from when it exists")
+	flags.StringVar(&options.Report, "report", "", "file to write rejected records to, one JSON object per line")
+	flags.BoolVar(&options.DryRun, "dry-run", false, "validate the file without writing movies")
+
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 2 {
+		flags.Usage()
+		return 2
+	}
+	options.Format = movies.ImportFormat(flags.Arg(0))
+	options.Path = flags.Arg(1)
+
+	var err error
+	if options.Mapping, err = parseFieldMapping(*mapping); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 2
+	}
+
+	dbConnections, err := shared.NewDatabaseConnections(context.Background(), logger)
+	if err != nil {
+		logger.Error("failed to connect to databases", slog.Any("error", err))
+		return 1
+	}
+	defer func(dbConnections *shared.DatabaseConnections) {
+		if err := dbConnections.Close(); err != nil {
+			logger.Error("failed to close database connections", slog.Any("error", err))
+		}
+	}(dbConnections)
+
+	movieRepo := movies.NewMongoRepository(dbConnections.MongoDB, logger)
+	if err := movieRepo.CreateIndexes(context.Background()); err != nil {
+		logger.Error("failed to create MongoDB indexes", slog.Any("error", err))
+		return 1
+	}
+
+	eventBus := shared.GlobalEventBus
+	if !options.DryRun {
+		if err := eventBus.Connect(); err != nil {
+			logger.Error("failed to connect to Kafka", slog.Any("error", err))
+			return 1
+		}
+		defer eventBus.SyncProducer.Close()
+	}
+
+	// Stopping aborts the batch being written. Its upserts are safe to
+	// repeat, so a resumed import writes it again from the start.
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	summary, err := movies.NewImporter(movieRepo, eventBus, logger).Import(ctx, options)
+	fmt.Printf("read %d, inserted %d, updated %d, rejected %d, skipped %d\n",
+		summary.Read, summary.Inserted, summary.Updated, summary.Rejected, summary.Skipped)
+	if err != nil {
+		logger.Error("import failed", slog.String("path", options.Path), slog.Any("error", err))
+		return 1
+	}
+
+	return 0
+}
+
+// parseFieldMapping parses field=column pairs separated by commas.
+func parseFieldMapping(value string) (map[string]string, error) {
+	mapping := make(map[string]string)
+	if value == "" {
+		return mapping, nil
+	}
+
+	for _, pair := range strings.Split(value, ",") {
+		field, column, ok := strings.Cut(pair, "=")
+		field, column = strings.TrimSpace(field), strings.TrimSpace(column)
+		if !ok || field == "" || column == "" {
+			return nil, fmt.Errorf("invalid field mapping %q, expected field=column", pair)
+		}
+		mapping[field] = column
+	}
+
+	return mapping, nil
+}
@@ -2,241 +2,264 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	schema "github.com/nameteos/my-movies-db-schema/mongodb"
-	"log"
+	"log/slog"
+	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
-	"time"
 
+	"event-driven-go/internal/domains/auth"
 	"event-driven-go/internal/domains/library"
 	"event-driven-go/internal/domains/movies"
+	"event-driven-go/internal/domains/people"
 	"event-driven-go/internal/domains/rating"
 	"event-driven-go/internal/domains/user"
 	"event-driven-go/internal/domains/watchlist"
 	"event-driven-go/internal/shared"
 
-	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
+	"gorm.io/gorm"
 )
 
 func main() {
-	logger := log.New(os.Stdout, "[MOVIES-GO] ", log.LstdFlags|log.Lshortfile)
+	logger := shared.Logger
 
-	logger.Println("Starting ...")
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		os.Exit(runMigrateCommand(os.Args[2:], logger))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "import" {
+		os.Exit(runImportCommand(os.Args[2:], logger))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "dedupe" {
+		os.Exit(runDedupeCommand(os.Args[2:], logger))
+	}
+	if len(os.Args) > 1 && os.Args[1] == "reindex" {
+		os.Exit(runReindexCommand(os.Args[2:], logger))
+	}
 
-	dbConnections, err := shared.NewDatabaseConnections()
+	logger.Info("starting application", slog.String("environment", shared.Config.App.Environment))
+
+	dbConnections, err := shared.NewDatabaseConnections(context.Background(), logger)
 	if err != nil {
-		logger.Fatalf("❌ Failed to connect to databases: %v", err)
+		logger.Error("failed to connect to databases", slog.Any("error", err))
+		os.Exit(1)
 	}
 	defer func(dbConnections *shared.DatabaseConnections) {
-		err := dbConnections.Close()
-		if err != nil {
-
+		if err := dbConnections.Close(); err != nil {
+			logger.Error("failed to close database connections", slog.Any("error", err))
 		}
 	}(dbConnections)
 
-	userRepo := user.NewRepository(dbConnections.PostgreSQL)
-	watchlistRepo := watchlist.NewRepository(dbConnections.PostgreSQL)
-	libraryRepo := library.NewRepository(dbConnections.PostgreSQL)
-	ratingRepo := rating.NewRepository(dbConnections.PostgreSQL)
-	movieRepo := movies.NewMongoRepository(dbConnections.MongoDB)
-
-	if err := runMigrations(userRepo, watchlistRepo, libraryRepo, ratingRepo); err != nil {
-		logger.Fatalf("❌ Migration failed: %v", err)
+	userRepo := user.NewRepository(dbConnections.PostgreSQL, logger)
+	watchlistRepo := watchlist.NewRepository(dbConnections.PostgreSQL, logger)
+	libraryRepo := library.NewRepository(dbConnections.PostgreSQL, logger)
+	ratingRepo := rating.NewRepository(dbConnections.PostgreSQL, logger)
+	authRepo := auth.NewRepository(dbConnections.PostgreSQL, logger)
+	movieRepo := movies.NewMongoRepository(dbConnections.MongoDB, logger)
+	peopleRepo := people.NewRepository(dbConnections.MongoDB, logger)
+
+	if err := runMigrations(context.Background(), dbConnections, logger); err != nil {
+		logger.Error("migration failed", slog.Any("error", err))
+		os.Exit(1)
 	}
 	if err := movieRepo.CreateIndexes(context.Background()); err != nil {
-		logger.Fatalf("❌️  Warning: Failed to create MongoDB indexes: %v", err)
+		logger.Error("failed to create MongoDB indexes", slog.Any("error", err))
+		os.Exit(1)
 	}
-
-	logger.Println("✅ App setup finished.")
-
-	eventBus := shared.NewEventBus()
-	go shared.GlobalEventBus.StartConsumers(context.Background())
-
-	userService := user.NewService(userRepo, eventBus)
-	demonstrateGormFeatures(userService, watchlistRepo, libraryRepo, ratingRepo, movieRepo, logger)
-
-	// Keep the application running until terminated
-	sigChan := make(chan os.Signal, 1)
-	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
-	<-sigChan
-
-	eventBus.SyncProducer.Close()
-	logger.Println("Shutting down application...")
-}
-
-func runMigrations(
-	userRepo *user.Repository,
-	watchlistRepo *watchlist.Repository,
-	libraryRepo *library.Repository,
-	ratingRepo *rating.Repository,
-) error {
-	if err := userRepo.AutoMigrate(); err != nil {
-		return fmt.Errorf("user migration failed: %w", err)
+	if err := peopleRepo.CreateIndexes(context.Background()); err != nil {
+		logger.Error("failed to create MongoDB indexes", slog.Any("error", err))
+		os.Exit(1)
 	}
 
-	if err := watchlistRepo.AutoMigrate(); err != nil {
-		return fmt.Errorf("watchlist migration failed: %w", err)
-	}
-
-	if err := libraryRepo.AutoMigrate(); err != nil {
-		return fmt.Errorf("library migration failed: %w", err)
-	}
-
-	if err := ratingRepo.AutoMigrate(); err != nil {
-		return fmt.Errorf("rating migration failed: %w", err)
-	}
-
-	return nil
-}
-
-func demonstrateGormFeatures(
-	userService *user.Service,
-	watchlistRepo *watchlist.Repository,
-	libraryRepo *library.Repository,
-	ratingRepo *rating.Repository,
-	movieRepo *movies.MongoRepository,
-	logger *log.Logger,
-) {
-	ctx := context.Background()
-
-	logger.Println("\n👤 PHASE 1: User Domain Operations")
-	logger.Println("==================================")
-
-	// Create sample users
-	user1, err := userService.RegisterUser(ctx, "movieew32we21111313", "f1anfe32ffwe1s11fsd@1movies.com")
+	embedder, err := movies.NewEmbedder(shared.Config.Embeddings)
 	if err != nil {
-		logger.Printf("❌ Failed to register user 1: %v", err)
-		return
+		logger.Error("failed to create embedder", slog.Any("error", err))
+		os.Exit(1)
 	}
-	logger.Printf("✅ Registered user: %s (%s)", user1.Username, user1.Email)
 
-	user2, err := userService.RegisterUser(ctx, "cinephile", "cinephile2@example.com")
+	searchIndex, err := movies.OpenSearchIndex(shared.Config.Search.IndexPath, logger)
 	if err != nil {
-		logger.Printf("❌ Failed to register user 2: %v", err)
-		return
+		logger.Error("failed to open search index", slog.Any("error", err))
+		os.Exit(1)
 	}
-	logger.Printf("✅ Registered user: %s (%s)", user2.Username, user2.Email)
-
-	// List users
-	users, err := userService.ListUsers(ctx, 10, 0)
-	if err != nil {
-		logger.Printf("❌ Failed to list users: %v", err)
-	} else {
-		logger.Printf("📋 Total registered users: %d", len(users))
-	}
-
-	logger.Println("\n🎬 PHASE 2: Creating Movies in MongoDB")
-	logger.Println("=====================================")
-
-	// Create sample movies
-	sampleMovies := []*schema.Movie{
-		{
-			ID:    primitive.NewObjectID(),
-			Title: "The Shawshank Redemption",
-		},
-		{
-			ID:    primitive.NewObjectID(),
-			Title: "The Godfather",
-		},
-	}
-
-	// Store movies in MongoDB
-	var createdMovies []*schema.Movie
-	for _, movie := range sampleMovies {
-		created, err := movieRepo.CreateMovie(ctx, movie)
-		if err != nil {
-			logger.Printf("❌ Failed to create movie %s: %v", movie.Title, err)
-			continue
+	defer func() {
+		if err := searchIndex.Close(); err != nil {
+			logger.Error("failed to close search index", slog.Any("error", err))
 		}
-		createdMovies = append(createdMovies, created)
-		logger.Printf("📽️  Created: %s (%d)", created.Title, created.ReleaseDate)
-	}
+	}()
 
-	logger.Println("\n📋 PHASE 3: GORM Watchlist Operations")
-	logger.Println("===================================")
+	logger.Info("app setup finished")
 
-	// Add movies to user's watchlist
-	for i, movie := range createdMovies {
-		notes := fmt.Sprintf("Must watch #%d - heard amazing things!", i+1)
-		_, err := watchlistRepo.AddToWatchlist(ctx, user1.ID, movie.ID.Hex(), notes)
-		if err != nil {
-			logger.Printf("❌ Failed to add %s to watchlist: %v", movie.Title, err)
-			continue
-		}
-		logger.Printf("✅ Added to %s's watchlist: %s", user1.Username, movie.Title)
+	eventBus := shared.GlobalEventBus
+	movies.RegisterEventHandler(eventBus, movies.NewHandler(logger, movieRepo, embedder))
+	watchlist.RegisterEventHandler(eventBus, watchlist.NewHandler(logger, movieRepo))
+	library.RegisterEventHandler(eventBus, library.NewHandler(logger, movieRepo))
+	if err := eventBus.Connect(); err != nil {
+		logger.Error("failed to connect to Kafka", slog.Any("error", err))
+		os.Exit(1)
 	}
-
-	// Get user's watchlist
-	userWatchlist, err := watchlistRepo.GetUserWatchlist(ctx, user1.ID)
-	if err != nil {
-		logger.Printf("❌ Failed to get watchlist: %v", err)
-	} else {
-		logger.Printf("📋 %s has %d movies in watchlist", user1.Username, len(userWatchlist))
+	go eventBus.StartConsumers(context.Background())
+
+	// The search index is a file of this instance, which has to see every
+	// movie event rather than its share of the partitions
+	searchEventBus := shared.NewGroupEventBus(shared.Config.Search.ConsumerGroup, logger)
+	movies.RegisterSearchIndexHandler(searchEventBus, movies.NewSearchIndexHandler(logger, movieRepo, searchIndex))
+	if err := searchEventBus.Connect(); err != nil {
+		logger.Error("failed to connect to Kafka", slog.Any("error", err))
+		os.Exit(1)
+	}
+	go searchEventBus.StartConsumers(context.Background())
+
+	// The credits of a deleted movie are removed once, by whichever instance
+	// of the people consumer group gets the event
+	peopleEventBus := shared.NewGroupEventBus(shared.Config.Kafka.ConsumerGroup+"-people", logger)
+	people.RegisterMovieEventHandler(peopleEventBus, people.NewHandler(logger, peopleRepo))
+	if err := peopleEventBus.Connect(); err != nil {
+		logger.Error("failed to connect to Kafka", slog.Any("error", err))
+		os.Exit(1)
+	}
+	go peopleEventBus.StartConsumers(context.Background())
+
+	relayCtx, stopRelay := context.WithCancel(context.Background())
+	go shared.NewOutboxRelay(dbConnections.PostgreSQL, eventBus, logger).Run(relayCtx)
+
+	// A new index, or one kept in memory
+	if count, err := searchIndex.Count(); err == nil && count == 0 {
+		go func() {
+			indexed, err := searchIndex.IndexAll(relayCtx, movieRepo)
+			if err != nil {
+				logger.Error("failed to fill search index", slog.Any("error", err))
+				return
+			}
+			logger.Info("search index filled", slog.Int("movies", indexed))
+		}()
 	}
 
-	logger.Println("\n📚 PHASE 4: GORM Library Operations")
-	logger.Println("==================================")
-
-	// Mark movies as watched
-	for i, movie := range createdMovies {
-		watchedAt := time.Now().Add(-time.Duration(i+1) * 24 * time.Hour)
-		_, err := libraryRepo.AddWatchHistory(ctx, user1.ID, movie.ID.Hex(), watchedAt, movie.Runtime)
+	// Movies from before embeddings were computed, or from another model
+	go func() {
+		embedded, err := movies.EmbedMissingMovies(relayCtx, movieRepo, embedder)
 		if err != nil {
-			logger.Printf("❌ Failed to add watch history for %s: %v", movie.Title, err)
-			continue
+			logger.Error("failed to embed movies", slog.Any("error", err))
+			return
+		}
+		logger.Info("movies embedded", slog.Int("movies", embedded), slog.String("model", embedder.Model()))
+	}()
+
+	userUnitOfWork := shared.NewUnitOfWork(dbConnections.PostgreSQL, func(tx *gorm.DB) user.TxRepositories {
+		return user.TxRepositories{
+			Users: user.NewRepository(tx, logger),
+			UserData: []user.UserDataRepository{
+				watchlist.NewRepository(tx, logger),
+				library.NewRepository(tx, logger),
+				rating.NewRepository(tx, logger),
+				auth.NewRepository(tx, logger),
+			},
+			Outbox: shared.NewOutbox(tx),
 		}
-		logger.Printf("📺 %s watched: %s", user1.Username, movie.Title)
+	})
+
+	userService := user.NewService(userRepo, userUnitOfWork, eventBus, logger)
+	movieService := movies.NewService(movieRepo, embedder, searchIndex, newMovieUnitOfWork(dbConnections.PostgreSQL, peopleRepo, logger), eventBus, logger)
+	libraryService := library.NewService(libraryRepo, userService, movieService, eventBus, logger)
+	watchlistService := watchlist.NewService(watchlistRepo, userService, movieService, libraryService, eventBus, logger)
+	ratingService := rating.NewService(ratingRepo, userService, movieService, eventBus, logger)
+	peopleService := people.NewService(peopleRepo, movieService, eventBus, logger)
+	authService := auth.NewService(authRepo, userService, shared.Config.Auth, logger)
+
+	healthChecker := shared.NewHealthChecker(shared.Config.HTTP.HealthCheckTimeout, logger)
+	healthChecker.Register("postgresql", dbConnections.PingPostgreSQL)
+	healthChecker.Register("mongodb", dbConnections.PingMongoDB)
+	healthChecker.Register("kafka_brokers", eventBus.CheckBrokers)
+	healthChecker.Register("kafka_consumer_group", eventBus.CheckConsumerGroup)
+	healthChecker.Register("kafka_search_consumer_group", searchEventBus.CheckConsumerGroup)
+	healthChecker.Register("kafka_people_consumer_group", peopleEventBus.CheckConsumerGroup)
+
+	appServices := services{
+		health:    healthChecker,
+		auth:      authService,
+		users:     userService,
+		movies:    movieService,
+		watchlist: watchlistService,
+		library:   libraryService,
+		ratings:   ratingService,
+		people:    peopleService,
 	}
+	router := newRouter(appServices, logger)
+
+	server := shared.NewHTTPServer(router, logger)
+	go func() {
+		logger.Info("starting HTTP server", slog.String("addr", server.Addr))
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("HTTP server failed", slog.Any("error", err))
+			os.Exit(1)
+		}
+	}()
 
-	// Get watching statistics
-	stats, err := libraryRepo.GetWatchingStats(ctx, user1.ID)
+	grpcServer := newGRPCServer(appServices, logger)
+	grpcListener, err := net.Listen("tcp", fmt.Sprintf(":%d", shared.Config.GRPC.Port))
 	if err != nil {
-		logger.Printf("❌ Failed to get stats: %v", err)
-	} else {
-		logger.Printf("📊 %s's Stats: Total movies: %v, Total hours: %.1f",
-			user1.Username, stats["total_movies"], stats["total_hours"])
+		logger.Error("failed to listen for gRPC", slog.Any("error", err))
+		os.Exit(1)
 	}
+	go func() {
+		logger.Info("starting gRPC server", slog.String("addr", grpcListener.Addr().String()))
+		if err := grpcServer.Serve(grpcListener); err != nil {
+			logger.Error("gRPC server failed", slog.Any("error", err))
+			os.Exit(1)
+		}
+	}()
 
-	logger.Println("\n⭐ PHASE 5: GORM Rating Operations")
-	logger.Println("=================================")
+	// Keep the application running until terminated
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
+	<-sigChan
 
-	// Rate the watched movies
-	ratings := []struct {
-		movie  *schema.Movie
-		rating float64
-		review string
-	}{
-		{createdMovies[0], 4.8, "Absolutely incredible! One of the best films ever made."},
-		{createdMovies[1], 4.9, "A masterpiece of cinema. Perfect storytelling and acting."},
+	shutdownCtx, cancelShutdown := context.WithTimeout(context.Background(), shared.Config.HTTP.ShutdownTimeout)
+	defer cancelShutdown()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		logger.Error("failed to shut down HTTP server", slog.Any("error", err))
 	}
+	stopGRPCServer(shutdownCtx, grpcServer)
 
-	for _, r := range ratings {
-		movieRating, err := ratingRepo.UpsertRating(ctx, user1.ID, r.movie.ID.Hex(), r.rating, r.review)
-		if err != nil {
-			logger.Printf("❌ Failed to rate %s: %v", r.movie.Title, err)
-			continue
+	stopRelay()
+	eventBus.SyncProducer.Close()
+	searchEventBus.SyncProducer.Close()
+	peopleEventBus.SyncProducer.Close()
+	logger.Info("shutting down application")
+}
+
+// newMovieUnitOfWork covers the data of other domains that refers to movies,
+// which merging movies rewrites. The credits are kept in MongoDB and only
+// take part in the merge, not in its transaction.
+func newMovieUnitOfWork(db *gorm.DB, credits *people.Repository, logger *slog.Logger) *shared.UnitOfWork[movies.TxRepositories] {
+	return shared.NewUnitOfWork(db, func(tx *gorm.DB) movies.TxRepositories {
+		return movies.TxRepositories{
+			References: []movies.MovieReferenceRepository{
+				watchlist.NewRepository(tx, logger),
+				library.NewRepository(tx, logger),
+				rating.NewRepository(tx, logger),
+				credits,
+			},
+			Outbox: shared.NewOutbox(tx),
 		}
-		logger.Printf("⭐ %s rated: %s - %.1f/5", user1.Username, r.movie.Title, movieRating.Rating)
-	}
+	})
+}
 
-	// Update user profile
-	user1.Username = "moviefan123_updated"
-	updatedUser, err := userService.UpdateUser(ctx, user1)
-	if err != nil {
-		logger.Printf("❌ Failed to update user: %v", err)
-	} else {
-		logger.Printf("✅ Updated user profile: %s", updatedUser.Username)
-	}
-
-	logger.Println("\n🎉 GORM Demo with User Domain Completed Successfully!")
-	logger.Println("===================================================")
-	logger.Printf("🔍 Key Features Demonstrated:")
-	logger.Printf("✅ User domain with complete CRUD operations")
-	logger.Printf("✅ Event-driven user lifecycle management")
-	logger.Printf("✅ Cross-domain data relationships")
-	logger.Printf("✅ GORM auto-migrations and type safety")
-	logger.Printf("✅ MongoDB + PostgreSQL hybrid architecture")
-	logger.Printf("✅ Domain-driven design principles")
+// stopGRPCServer waits for running calls to finish, cancelling those still
+// running, such as long streams, once ctx expires.
+func stopGRPCServer(ctx context.Context, server *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		server.Stop()
+	}
 }
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"event-driven-go/internal/migrations"
+	"event-driven-go/internal/shared"
+)
+
+const migrateUsage = "usage: main migrate <up|down [steps]|status>"
+
+func runMigrations(ctx context.Context, dbConnections *shared.DatabaseConnections, logger *slog.Logger) error {
+	migrator, err := migrations.NewMigrator(dbConnections.PostgreSQL, logger)
+	if err != nil {
+		return err
+	}
+
+	return migrator.Up(ctx)
+}
+
+// runMigrateCommand implements `migrate up`, `migrate down [steps]` and
+// `migrate status`, returning the process exit code.
+func runMigrateCommand(args []string, logger *slog.Logger) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+
+	dbConnections, err := shared.NewDatabaseConnections(context.Background(), logger)
+	if err != nil {
+		logger.Error("failed to connect to databases", slog.Any("error", err))
+		return 1
+	}
+	defer func(dbConnections *shared.DatabaseConnections) {
+		if err := dbConnections.Close(); err != nil {
+			logger.Error("failed to close database connections", slog.Any("error", err))
+		}
+	}(dbConnections)
+
+	migrator, err := migrations.NewMigrator(dbConnections.PostgreSQL, logger)
+	if err != nil {
+		logger.Error("failed to load migrations", slog.Any("error", err))
+		return 1
+	}
+
+	ctx := context.Background()
+
+	switch args[0] {
+	case "up":
+		err = migrator.Up(ctx)
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			steps, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Fprintln(os.Stderr, migrateUsage)
+				return 2
+			}
+		}
+		err = migrator.Down(ctx, steps)
+	case "status":
+		err = printMigrationStatus(ctx, migrator)
+	default:
+		fmt.Fprintln(os.Stderr, migrateUsage)
+		return 2
+	}
+
+	if err != nil {
+		logger.Error("migrate command failed", slog.String("command", args[0]), slog.Any("error", err))
+		return 1
+	}
+
+	return 0
+}
+
+func printMigrationStatus(ctx context.Context, migrator *migrations.Migrator) error {
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED AT")
+	for _, status := range statuses {
+		state, appliedAt := "pending", "-"
+		if status.Applied {
+			state, appliedAt = "applied", status.AppliedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(w, "%04d\t%s\t%s\t%s\n", status.Version, status.Name, state, appliedAt)
+	}
+
+	return w.Flush()
+}
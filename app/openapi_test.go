@@ -0,0 +1,96 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"event-driven-go/internal/shared"
+)
+
+const openAPIGoldenFile = "../api/openapi.json"
+
+var update = flag.Bool("update", false, "rewrite "+openAPIGoldenFile+" from the registered routes")
+
+func serveOpenAPI(t *testing.T) (*shared.Router, []byte) {
+	t.Helper()
+
+	router := newRouter(services{health: shared.NewHealthChecker(time.Second, shared.Logger)}, shared.Logger)
+
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/openapi.json", nil))
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("GET /openapi.json returned %d", recorder.Code)
+	}
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, recorder.Body.Bytes(), "", "  "); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+
+	return router, indented.Bytes()
+}
+
+// TestOpenAPIDocumentIsUpToDate fails when a route or one of the types it
+// exchanges changed without regenerating the committed document with
+// go test ./app -run TestOpenAPIDocumentIsUpToDate -update
+func TestOpenAPIDocumentIsUpToDate(t *testing.T) {
+	_, document := serveOpenAPI(t)
+
+	if *update {
+		if err := os.WriteFile(openAPIGoldenFile, document, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	golden, err := os.ReadFile(openAPIGoldenFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(golden, document) {
+		t.Errorf("%s is out of date, rerun the test with -update and review the diff", openAPIGoldenFile)
+	}
+}
+
+// TestOpenAPIOperationsAreRouted checks that every documented operation is
+// answered by the route it documents rather than by another pattern.
+func TestOpenAPIOperationsAreRouted(t *testing.T) {
+	router, body := serveOpenAPI(t)
+
+	var document shared.OpenAPIDocument
+	if err := json.Unmarshal(body, &document); err != nil {
+		t.Fatal(err)
+	}
+
+	operations := 0
+	for path, methods := range document.Paths {
+		for method, operation := range methods {
+			operations++
+			pattern := strings.ToUpper(method) + " " + path
+
+			if operation.Summary == "" {
+				t.Errorf("%s has no summary", pattern)
+			}
+
+			url := path
+			for _, param := range operation.Parameters {
+				if param.In == "path" {
+					url = strings.ReplaceAll(url, "{"+param.Name+"}", "0123456789abcdef01234567")
+				}
+			}
+			if routed := router.Pattern(httptest.NewRequest(strings.ToUpper(method), url, nil)); routed != pattern {
+				t.Errorf("%s %s is routed to %q, want %q", strings.ToUpper(method), url, routed, pattern)
+			}
+		}
+	}
+
+	if operations != len(router.Routes()) {
+		t.Errorf("document has %d operations, router has %d routes", operations, len(router.Routes()))
+	}
+}
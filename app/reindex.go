@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"event-driven-go/internal/domains/movies"
+	"event-driven-go/internal/shared"
+)
+
+const reindexUsage = "usage: main reindex"
+
+// runReindexCommand implements `reindex`, which rebuilds the full text search
+// index from the catalog, returning the process exit code. The application
+// has to be stopped, as it keeps the index open.
+func runReindexCommand(args []string, logger *slog.Logger) int {
+	flags := flag.NewFlagSet("reindex", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), reindexUsage)
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+	if flags.NArg() != 0 {
+		flags.Usage()
+		return 2
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	dbConnections, err := shared.NewDatabaseConnections(ctx, logger)
+	if err != nil {
+		logger.Error("failed to connect to databases", slog.Any("error", err))
+		return 1
+	}
+	defer func(dbConnections *shared.DatabaseConnections) {
+		if err := dbConnections.Close(); err != nil {
+			logger.Error("failed to close database connections", slog.Any("error", err))
+		}
+	}(dbConnections)
+
+	started := time.Now()
+	movieRepo := movies.NewMongoRepository(dbConnections.MongoDB, logger)
+	indexed, err := movies.RebuildSearchIndex(ctx, shared.Config.Search.IndexPath, movieRepo, logger)
+	if err != nil {
+		logger.Error("failed to rebuild search index", slog.Any("error", err))
+		return 1
+	}
+
+	fmt.Printf("indexed %d movies into %s in %s\n", indexed, shared.Config.Search.IndexPath, time.Since(started).Round(time.Millisecond))
+	return 0
+}
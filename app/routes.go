@@ -0,0 +1,94 @@
+package main
+
+import (
+	"log/slog"
+	"net/http"
+
+	"event-driven-go/internal/domains/auth"
+	"event-driven-go/internal/domains/library"
+	"event-driven-go/internal/domains/movies"
+	"event-driven-go/internal/domains/people"
+	"event-driven-go/internal/domains/rating"
+	"event-driven-go/internal/domains/user"
+	"event-driven-go/internal/domains/watchlist"
+	"event-driven-go/internal/graph"
+	"event-driven-go/internal/shared"
+	"google.golang.org/grpc"
+)
+
+var apiInfo = shared.OpenAPIInfo{
+	Title:   "My Movies API",
+	Version: "1.0.0",
+}
+
+type services struct {
+	health    *shared.HealthChecker
+	auth      *auth.Service
+	users     *user.Service
+	movies    *movies.Service
+	watchlist *watchlist.Service
+	library   *library.Service
+	ratings   *rating.Service
+	people    *people.Service
+}
+
+// newRouter registers every HTTP endpoint. The OpenAPI document served at
+// /openapi.json is generated from the same registrations.
+func newRouter(s services, logger *slog.Logger) *shared.Router {
+	router := shared.NewRouter(s.auth)
+
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/healthz",
+		Tag:      "health",
+		Summary:  "Liveness probe",
+		Response: shared.HealthReport{},
+	}, s.health.LivenessHandler())
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/readyz",
+		Tag:      "health",
+		Summary:  "Readiness probe with one check per dependency, 503 with the same body when one fails",
+		Response: shared.HealthReport{},
+	}, s.health.ReadinessHandler())
+
+	auth.NewHTTPHandler(s.auth, logger).RegisterRoutes(router)
+	user.NewHTTPHandler(s.users, logger).RegisterRoutes(router)
+	movies.NewHTTPHandler(s.movies, logger).RegisterRoutes(router)
+	watchlist.NewHTTPHandler(s.watchlist, logger).RegisterRoutes(router)
+	library.NewHTTPHandler(s.library, logger).RegisterRoutes(router)
+	rating.NewHTTPHandler(s.ratings, logger).RegisterRoutes(router)
+	people.NewHTTPHandler(s.people, logger).RegisterRoutes(router)
+	graph.NewHTTPHandler(graph.Services{
+		Users:     s.users,
+		Movies:    s.movies,
+		Watchlist: s.watchlist,
+		Library:   s.library,
+		Ratings:   s.ratings,
+	}, logger).RegisterRoutes(router)
+
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/openapi.json",
+		Tag:      "meta",
+		Summary:  "This OpenAPI document",
+		Response: map[string]interface{}{},
+	}, router.OpenAPIHandler(apiInfo))
+
+	return router
+}
+
+// newGRPCServer registers the gRPC counterpart of every domain's endpoints.
+func newGRPCServer(s services, logger *slog.Logger) *grpc.Server {
+	server := shared.NewGRPCServer(logger, s.auth)
+
+	auth.NewGRPCServer(s.auth, logger).Register(server)
+	user.NewGRPCServer(s.users, logger).Register(server)
+	movies.NewGRPCServer(s.movies, logger).Register(server)
+	watchlist.NewGRPCServer(s.watchlist, logger).Register(server)
+	library.NewGRPCServer(s.library, logger).Register(server)
+	rating.NewGRPCServer(s.ratings, logger).Register(server)
+	people.NewGRPCServer(s.people, logger).Register(server)
+
+	return server
+}
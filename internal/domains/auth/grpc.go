@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"context"
+	"log/slog"
+
+	moviesdbv1 "event-driven-go/internal/gen/moviesdb/v1"
+	"event-driven-go/internal/shared"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GRPCServer implements moviesdb.v1.AuthService on top of the service.
+type GRPCServer struct {
+	moviesdbv1.UnimplementedAuthServiceServer
+	service *Service
+	logger  *slog.Logger
+}
+
+func NewGRPCServer(service *Service, logger *slog.Logger) *GRPCServer {
+	return &GRPCServer{
+		service: service,
+		logger:  logger.With(slog.String("domain", "auth")),
+	}
+}
+
+func (s *GRPCServer) Register(server *grpc.Server) {
+	moviesdbv1.RegisterAuthServiceServer(server, s)
+}
+
+func (s *GRPCServer) Login(ctx context.Context, req *moviesdbv1.LoginRequest) (*moviesdbv1.TokenPair, error) {
+	fields := make(map[string]string)
+	if req.GetUsername() == "" {
+		fields["username"] = "must not be empty"
+	}
+	if req.GetPassword() == "" {
+		fields["password"] = "must not be empty"
+	}
+	if len(fields) > 0 {
+		return nil, shared.NewValidationError("invalid login", fields)
+	}
+
+	pair, err := s.service.Login(ctx, req.GetUsername(), req.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoTokenPair(pair), nil
+}
+
+func (s *GRPCServer) RefreshToken(ctx context.Context, req *moviesdbv1.RefreshTokenRequest) (*moviesdbv1.TokenPair, error) {
+	if req.GetRefreshToken() == "" {
+		return nil, shared.NewFieldError("refresh_token", "must not be empty")
+	}
+
+	pair, err := s.service.Refresh(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoTokenPair(pair), nil
+}
+
+func (s *GRPCServer) Logout(ctx context.Context, req *moviesdbv1.LogoutRequest) (*emptypb.Empty, error) {
+	if req.GetRefreshToken() == "" {
+		return nil, shared.NewFieldError("refresh_token", "must not be empty")
+	}
+
+	if err := s.service.Logout(ctx, req.GetRefreshToken()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func toProtoTokenPair(pair *TokenPair) *moviesdbv1.TokenPair {
+	return &moviesdbv1.TokenPair{
+		AccessToken:           pair.AccessToken,
+		TokenType:             pair.TokenType,
+		ExpiresIn:             int32(pair.ExpiresIn),
+		RefreshToken:          pair.RefreshToken,
+		RefreshTokenExpiresAt: timestamppb.New(pair.RefreshTokenExpiresAt),
+	}
+}
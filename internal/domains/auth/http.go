@@ -0,0 +1,175 @@
+package auth
+
+import (
+	"log/slog"
+	"net/http"
+
+	"event-driven-go/internal/domains/user"
+	"event-driven-go/internal/shared"
+)
+
+type HTTPHandler struct {
+	service *Service
+	logger  *slog.Logger
+}
+
+func NewHTTPHandler(service *Service, logger *slog.Logger) *HTTPHandler {
+	return &HTTPHandler{
+		service: service,
+		logger:  logger.With(slog.String("domain", "auth")),
+	}
+}
+
+func (h *HTTPHandler) RegisterRoutes(router *shared.Router) {
+	router.Handle(shared.Route{
+		Method:   http.MethodPost,
+		Path:     "/auth/login",
+		Tag:      "auth",
+		Summary:  "Log in with username and password",
+		Request:  LoginRequest{},
+		Response: TokenPair{},
+		Errors:   []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusUnprocessableEntity},
+	}, h.login)
+	router.Handle(shared.Route{
+		Method:   http.MethodPost,
+		Path:     "/auth/refresh",
+		Tag:      "auth",
+		Summary:  "Exchange a refresh token for a new token pair; each refresh token works once",
+		Request:  RefreshTokenRequest{},
+		Response: TokenPair{},
+		Errors:   []int{http.StatusBadRequest, http.StatusUnauthorized, http.StatusUnprocessableEntity},
+	}, h.refresh)
+	router.Handle(shared.Route{
+		Method:  http.MethodPost,
+		Path:    "/auth/logout",
+		Tag:     "auth",
+		Summary: "Revoke a refresh token and every token refreshed from the same login",
+		Request: RefreshTokenRequest{},
+		Status:  http.StatusNoContent,
+		Errors:  []int{http.StatusBadRequest, http.StatusUnprocessableEntity},
+	}, h.logout)
+	router.Handle(shared.Route{
+		Method:  http.MethodPost,
+		Path:    "/auth/logout-all",
+		Tag:     "auth",
+		Summary: "Revoke all refresh tokens of the authenticated user",
+		Status:  http.StatusNoContent,
+		Auth:    true,
+	}, h.logoutEverywhere)
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/auth/me",
+		Tag:      "auth",
+		Summary:  "The authenticated user",
+		Response: user.User{},
+		Auth:     true,
+	}, h.me)
+}
+
+type LoginRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type RefreshTokenRequest struct {
+	RefreshToken string `json:"refresh_token"`
+}
+
+func (h *HTTPHandler) login(w http.ResponseWriter, r *http.Request) {
+	var request LoginRequest
+	if err := shared.DecodeJSON(w, r, &request); err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	fields := make(map[string]string)
+	if request.Username == "" {
+		fields["username"] = "must not be empty"
+	}
+	if request.Password == "" {
+		fields["password"] = "must not be empty"
+	}
+	if len(fields) > 0 {
+		shared.WriteError(w, r, http.StatusUnprocessableEntity, "invalid login", fields)
+		return
+	}
+
+	pair, err := h.service.Login(r.Context(), request.Username, request.Password)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	writeTokenPair(w, pair)
+}
+
+func (h *HTTPHandler) refresh(w http.ResponseWriter, r *http.Request) {
+	token, ok := decodeRefreshToken(w, r)
+	if !ok {
+		return
+	}
+
+	pair, err := h.service.Refresh(r.Context(), token)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	writeTokenPair(w, pair)
+}
+
+func (h *HTTPHandler) logout(w http.ResponseWriter, r *http.Request) {
+	token, ok := decodeRefreshToken(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Logout(r.Context(), token); err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *HTTPHandler) logoutEverywhere(w http.ResponseWriter, r *http.Request) {
+	userID, _ := shared.UserIDFromContext(r.Context())
+
+	if err := h.service.LogoutEverywhere(r.Context(), userID); err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *HTTPHandler) me(w http.ResponseWriter, r *http.Request) {
+	u, err := h.service.CurrentUser(r.Context())
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, u)
+}
+
+func decodeRefreshToken(w http.ResponseWriter, r *http.Request) (string, bool) {
+	var request RefreshTokenRequest
+	if err := shared.DecodeJSON(w, r, &request); err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return "", false
+	}
+	if request.RefreshToken == "" {
+		shared.WriteError(w, r, http.StatusUnprocessableEntity, "invalid request",
+			map[string]string{"refresh_token": "must not be empty"})
+		return "", false
+	}
+
+	return request.RefreshToken, true
+}
+
+// writeTokenPair forbids caching, tokens must not end up in shared caches.
+func writeTokenPair(w http.ResponseWriter, pair *TokenPair) {
+	w.Header().Set("Cache-Control", "no-store")
+	shared.WriteJSON(w, http.StatusOK, pair)
+}
@@ -0,0 +1,122 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// errTokenAlreadyRotated means a refresh token was used twice concurrently.
+var errTokenAlreadyRotated = errors.New("refresh token already rotated")
+
+type Repository struct {
+	db     *gorm.DB
+	logger *slog.Logger
+}
+
+func NewRepository(db *gorm.DB, logger *slog.Logger) *Repository {
+	return &Repository{
+		db:     db,
+		logger: logger.With(slog.String("repository", "auth")),
+	}
+}
+
+func (r *Repository) CreateRefreshToken(ctx context.Context, token *RefreshToken) error {
+	if err := r.db.WithContext(ctx).Create(token).Error; err != nil {
+		return fmt.Errorf("failed to create refresh token: %w", err)
+	}
+	return nil
+}
+
+func (r *Repository) GetRefreshTokenByHash(ctx context.Context, tokenHash string) (*RefreshToken, error) {
+	var token RefreshToken
+
+	result := r.db.WithContext(ctx).Where("token_hash = ?", tokenHash).First(&token)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrInvalidToken
+		}
+		return nil, fmt.Errorf("failed to get refresh token: %w", result.Error)
+	}
+
+	return &token, nil
+}
+
+// RotateRefreshToken revokes current in favour of next within one transaction.
+// Of two concurrent rotations of the same token only one succeeds, the other
+// gets errTokenAlreadyRotated.
+func (r *Repository) RotateRefreshToken(ctx context.Context, current, next *RefreshToken) error {
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&RefreshToken{}).
+			Where("id = ? AND revoked_at IS NULL", current.ID).
+			Updates(map[string]interface{}{"revoked_at": time.Now(), "replaced_by": next.ID})
+		if result.Error != nil {
+			return fmt.Errorf("failed to revoke refresh token: %w", result.Error)
+		}
+		if result.RowsAffected == 0 {
+			return errTokenAlreadyRotated
+		}
+
+		if err := tx.Create(next).Error; err != nil {
+			return fmt.Errorf("failed to create refresh token: %w", err)
+		}
+		return nil
+	})
+}
+
+// RevokeTokenFamily revokes every refresh token descended from one login.
+func (r *Repository) RevokeTokenFamily(ctx context.Context, familyID string) error {
+	result := r.db.WithContext(ctx).
+		Model(&RefreshToken{}).
+		Where("family_id = ? AND revoked_at IS NULL", familyID).
+		Update("revoked_at", time.Now())
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to revoke refresh tokens: %w", result.Error)
+	}
+	r.logger.DebugContext(ctx, "refresh token family revoked",
+		slog.String("family_id", familyID),
+		slog.Int64("rows", result.RowsAffected),
+	)
+
+	return nil
+}
+
+// RevokeUserTokens revokes all refresh tokens of a user, logging them out everywhere.
+func (r *Repository) RevokeUserTokens(ctx context.Context, userID string) error {
+	result := r.db.WithContext(ctx).
+		Model(&RefreshToken{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now())
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to revoke refresh tokens: %w", result.Error)
+	}
+	r.logger.DebugContext(ctx, "user refresh tokens revoked",
+		slog.String("user_id", userID),
+		slog.Int64("rows", result.RowsAffected),
+	)
+
+	return nil
+}
+
+// DeleteUserData removes all refresh tokens belonging to a user.
+func (r *Repository) DeleteUserData(ctx context.Context, userID string) error {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&RefreshToken{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete user refresh tokens: %w", result.Error)
+	}
+	r.logger.DebugContext(ctx, "user refresh tokens deleted",
+		slog.String("user_id", userID),
+		slog.Int64("rows", result.RowsAffected),
+	)
+
+	return nil
+}
@@ -0,0 +1,162 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"event-driven-go/internal/domains/user"
+	"event-driven-go/internal/shared"
+	"github.com/google/uuid"
+)
+
+// Users is the part of the user service that authentication relies on.
+type Users interface {
+	VerifyPassword(ctx context.Context, username, password string) (*user.User, error)
+	GetUserByID(ctx context.Context, id string) (*user.User, error)
+}
+
+type Service struct {
+	repository *Repository
+	users      Users
+	config     shared.AuthConfig
+	logger     *slog.Logger
+}
+
+func NewService(repository *Repository, users Users, config shared.AuthConfig, logger *slog.Logger) *Service {
+	return &Service{
+		repository: repository,
+		users:      users,
+		config:     config,
+		logger:     logger.With(slog.String("domain", "auth")),
+	}
+}
+
+// Login checks the user's password and starts a new refresh token family.
+func (s *Service) Login(ctx context.Context, username, password string) (*TokenPair, error) {
+	u, err := s.users.VerifyPassword(ctx, username, password)
+	if err != nil {
+		return nil, err
+	}
+
+	pair, refreshToken, err := s.issueTokens(u, uuid.New().String())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repository.CreateRefreshToken(ctx, refreshToken); err != nil {
+		return nil, err
+	}
+	s.logger.InfoContext(ctx, "user logged in", slog.String("user_id", u.ID))
+
+	return pair, nil
+}
+
+// Refresh exchanges a refresh token for a new pair and revokes it. A token
+// that was already exchanged was either stolen or replayed, so its whole
+// family is revoked and the holder of the latest token has to log in again.
+func (s *Service) Refresh(ctx context.Context, token string) (*TokenPair, error) {
+	current, err := s.repository.GetRefreshTokenByHash(ctx, hashRefreshToken(token))
+	if err != nil {
+		return nil, err
+	}
+
+	if current.RevokedAt != nil {
+		s.revokeReusedFamily(ctx, current)
+		return nil, ErrInvalidToken
+	}
+	if time.Now().After(current.ExpiresAt) {
+		return nil, ErrInvalidToken
+	}
+	u, err := s.users.GetUserByID(ctx, current.UserID)
+	if err != nil {
+		if errors.Is(err, user.ErrUserNotFound) {
+			return nil, ErrInvalidToken
+		}
+		return nil, err
+	}
+
+	pair, next, err := s.issueTokens(u, current.FamilyID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repository.RotateRefreshToken(ctx, current, next); err != nil {
+		if errors.Is(err, errTokenAlreadyRotated) {
+			s.revokeReusedFamily(ctx, current)
+			return nil, ErrInvalidToken
+		}
+		return nil, err
+	}
+
+	return pair, nil
+}
+
+// Logout revokes the refresh token's family. Unknown tokens are ignored, so
+// logging out twice is not an error.
+func (s *Service) Logout(ctx context.Context, token string) error {
+	current, err := s.repository.GetRefreshTokenByHash(ctx, hashRefreshToken(token))
+	if errors.Is(err, ErrInvalidToken) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return s.repository.RevokeTokenFamily(ctx, current.FamilyID)
+}
+
+// LogoutEverywhere revokes all refresh tokens of a user. Access tokens stay
+// valid until they expire.
+func (s *Service) LogoutEverywhere(ctx context.Context, userID string) error {
+	return s.repository.RevokeUserTokens(ctx, userID)
+}
+
+// CurrentUser returns the user the request was authenticated as.
+func (s *Service) CurrentUser(ctx context.Context) (*user.User, error) {
+	userID, err := shared.AuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.users.GetUserByID(ctx, userID)
+}
+
+func (s *Service) issueTokens(u *user.User, familyID string) (*TokenPair, *RefreshToken, error) {
+	now := time.Now()
+
+	accessToken, err := s.issueAccessToken(u, now)
+	if err != nil {
+		return nil, nil, err
+	}
+	refreshToken, refreshTokenHash, err := newRefreshToken()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stored := &RefreshToken{
+		ID:        uuid.New().String(),
+		UserID:    u.ID,
+		FamilyID:  familyID,
+		TokenHash: refreshTokenHash,
+		ExpiresAt: now.Add(s.config.RefreshTokenTTL),
+	}
+	pair := &TokenPair{
+		AccessToken:           accessToken,
+		TokenType:             tokenType,
+		ExpiresIn:             int(s.config.AccessTokenTTL.Seconds()),
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: stored.ExpiresAt,
+	}
+
+	return pair, stored, nil
+}
+
+func (s *Service) revokeReusedFamily(ctx context.Context, token *RefreshToken) {
+	s.logger.WarnContext(ctx, "revoked refresh token reused, revoking its family",
+		slog.String("user_id", token.UserID),
+		slog.String("family_id", token.FamilyID),
+	)
+	if err := s.repository.RevokeTokenFamily(ctx, token.FamilyID); err != nil {
+		s.logger.ErrorContext(ctx, "failed to revoke refresh token family", slog.Any("error", err))
+	}
+}
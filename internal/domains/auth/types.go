@@ -0,0 +1,36 @@
+package auth
+
+import (
+	"time"
+
+	"event-driven-go/internal/shared"
+)
+
+var ErrInvalidToken = shared.NewUnauthenticatedError("invalid or expired token")
+
+// RefreshToken is the stored form of an issued refresh token. Only a SHA-256
+// hash of the token is kept. Tokens issued by refreshing share the FamilyID of
+// the login they descend from, so the whole chain can be revoked at once.
+type RefreshToken struct {
+	ID         string    `gorm:"primaryKey;type:varchar(36)"`
+	UserID     string    `gorm:"type:varchar(36);not null;index"`
+	FamilyID   string    `gorm:"type:varchar(36);not null;index"`
+	TokenHash  string    `gorm:"type:varchar(64);not null;uniqueIndex"`
+	ExpiresAt  time.Time `gorm:"not null"`
+	RevokedAt  *time.Time
+	ReplacedBy *string   `gorm:"type:varchar(36)"`
+	CreatedAt  time.Time `gorm:"autoCreateTime"`
+}
+
+func (RefreshToken) TableName() string {
+	return "refresh_tokens"
+}
+
+type TokenPair struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	// ExpiresIn is the lifetime of the access token in seconds
+	ExpiresIn             int       `json:"expires_in"`
+	RefreshToken          string    `json:"refresh_token"`
+	RefreshTokenExpiresAt time.Time `json:"refresh_token_expires_at"`
+}
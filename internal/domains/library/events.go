@@ -21,7 +21,14 @@ type MovieWatchedEvent struct {
 }
 
 func init() {
-	shared.GlobalEventBus.RegisterEventType(MovieWatchedEventType, &MovieWatchedEvent{}, &Handler{})
+	RegisterEventHandler(shared.GlobalEventBus, NewHandler(shared.Logger, nil))
+}
+
+// RegisterEventHandler registers the library events with the handler that
+// processes them. The application registers them again with a handler that
+// counts movie popularity.
+func RegisterEventHandler(eventBus *shared.EventBus, handler *Handler) {
+	eventBus.RegisterEventType(MovieWatchedEventType, &MovieWatchedEvent{}, handler)
 }
 
 func (e MovieWatchedEvent) GetPayload() interface{} {
@@ -0,0 +1,144 @@
+package library
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	moviesdbv1 "event-driven-go/internal/gen/moviesdb/v1"
+	"event-driven-go/internal/shared"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GRPCServer implements moviesdb.v1.LibraryService on top of the service.
+type GRPCServer struct {
+	moviesdbv1.UnimplementedLibraryServiceServer
+	service *Service
+	logger  *slog.Logger
+}
+
+func NewGRPCServer(service *Service, logger *slog.Logger) *GRPCServer {
+	return &GRPCServer{
+		service: service,
+		logger:  logger.With(slog.String("domain", "library")),
+	}
+}
+
+func (s *GRPCServer) Register(server *grpc.Server) {
+	moviesdbv1.RegisterLibraryServiceServer(server, s)
+}
+
+func (s *GRPCServer) MarkAsWatched(ctx context.Context, req *moviesdbv1.MarkAsWatchedRequest) (*moviesdbv1.WatchHistoryEntry, error) {
+	if _, err := shared.AuthenticatedUserID(ctx); err != nil {
+		return nil, err
+	}
+
+	var watchedAt time.Time
+	if req.WatchedAt != nil {
+		if err := req.WatchedAt.CheckValid(); err != nil {
+			return nil, shared.NewFieldError("watched_at", "must be a valid timestamp")
+		}
+		watchedAt = req.WatchedAt.AsTime()
+	}
+
+	history, err := s.service.MarkAsWatched(ctx, req.GetUserId(), req.GetMovieId(), watchedAt, int(req.GetDurationWatched()))
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoHistory(history), nil
+}
+
+func (s *GRPCServer) ListWatchHistory(ctx context.Context, req *moviesdbv1.ListWatchHistoryRequest) (*moviesdbv1.ListWatchHistoryResponse, error) {
+	if _, err := shared.AuthenticatedUserID(ctx); err != nil {
+		return nil, err
+	}
+
+	page, err := shared.ValidatePageRequest(req.GetLimit(), req.GetOffset(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	history, err := s.service.GetWatchHistory(ctx, req.GetUserId(), page)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &moviesdbv1.ListWatchHistoryResponse{
+		Entries:    make([]*moviesdbv1.WatchHistoryEntry, 0, len(history.Items)),
+		NextCursor: history.NextCursor,
+		PrevCursor: history.PrevCursor,
+	}
+	for _, entry := range history.Items {
+		response.Entries = append(response.Entries, toProtoHistory(entry))
+	}
+
+	return response, nil
+}
+
+// StreamWatchHistory pages through the history batch by batch with cursors, so
+// memory use does not grow with its length and entries added meanwhile do not
+// shift the batches. It stops early when the client goes away.
+func (s *GRPCServer) StreamWatchHistory(req *moviesdbv1.StreamWatchHistoryRequest, stream grpc.ServerStreamingServer[moviesdbv1.WatchHistoryEntry]) error {
+	if _, err := shared.AuthenticatedUserID(stream.Context()); err != nil {
+		return err
+	}
+
+	batchSize := int(req.GetBatchSize())
+	if batchSize == 0 {
+		batchSize = shared.MaxPageLimit
+	}
+	if batchSize < 0 || batchSize > shared.MaxPageLimit {
+		return shared.NewFieldError("batch_size", fmt.Sprintf("must be between 1 and %d", shared.MaxPageLimit))
+	}
+
+	ctx := stream.Context()
+	page := shared.PageRequest{Limit: batchSize}
+	for {
+		history, err := s.service.GetWatchHistory(ctx, req.GetUserId(), page)
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range history.Items {
+			if err := stream.Send(toProtoHistory(entry)); err != nil {
+				return err
+			}
+		}
+
+		if history.NextCursor == "" {
+			return nil
+		}
+		page.Cursor = history.NextCursor
+	}
+}
+
+func (s *GRPCServer) GetWatchingStats(ctx context.Context, req *moviesdbv1.GetWatchingStatsRequest) (*moviesdbv1.WatchingStats, error) {
+	if _, err := shared.AuthenticatedUserID(ctx); err != nil {
+		return nil, err
+	}
+
+	stats, err := s.service.GetWatchingStats(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &moviesdbv1.WatchingStats{
+		TotalMoviesWatched:          stats.TotalMoviesWatched,
+		TotalMinutes:                stats.TotalMinutes,
+		TotalHours:                  stats.TotalHours,
+		TotalMoviesWatchedThisMonth: stats.TotalMoviesWatchedThisMonth,
+	}, nil
+}
+
+func toProtoHistory(history *WatchHistory) *moviesdbv1.WatchHistoryEntry {
+	return &moviesdbv1.WatchHistoryEntry{
+		Id:              history.ID,
+		UserId:          history.UserID,
+		MovieId:         history.MovieID,
+		WatchedAt:       timestamppb.New(history.WatchedAt),
+		DurationWatched: int32(history.Duration),
+	}
+}
@@ -3,12 +3,25 @@ package library
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
 
+	"event-driven-go/internal/domains/movies"
 	"event-driven-go/internal/shared"
 )
 
-type Handler struct{}
+// Handler counts watches towards the popularity of movies, when given a
+// counter.
+type Handler struct {
+	popularity movies.PopularityCounter
+	logger     *slog.Logger
+}
+
+func NewHandler(logger *slog.Logger, popularity movies.PopularityCounter) *Handler {
+	return &Handler{
+		popularity: popularity,
+		logger:     logger.With(slog.String("domain", "library")),
+	}
+}
 
 func (h *Handler) Handle(ctx context.Context, event shared.Event) error {
 	switch e := event.(type) {
@@ -24,15 +37,20 @@ func (h *Handler) CanHandle(eventType string) bool {
 }
 
 func (h *Handler) handleMovieWatched(ctx context.Context, event *MovieWatchedEvent) error {
-	durationText := ""
-	if event.Duration > 0 {
-		durationText = fmt.Sprintf(" (Duration: %d min)", event.Duration)
+	h.logger.InfoContext(ctx, "user watched movie",
+		slog.String("user_id", event.UserID),
+		slog.String("movie_id", event.MovieID),
+		slog.String("title", event.Title),
+		slog.Time("watched_at", event.WatchedAt),
+		slog.Int("duration_minutes", event.Duration),
+	)
+
+	if h.popularity == nil {
+		return nil
 	}
 
-	log.Printf("📚 LIBRARY: User %s watched '%s' at %s%s",
-		event.UserID, event.Title, event.WatchedAt.Format("2006-01-02 15:04:05"), durationText)
-
-	// todo store move in DB, do other stuff
-
+	if err := h.popularity.AddPopularity(ctx, event.MovieID, 1); err != nil {
+		return fmt.Errorf("failed to count movie popularity: %w", err)
+	}
 	return nil
 }
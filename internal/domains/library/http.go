@@ -0,0 +1,127 @@
+package library
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"event-driven-go/internal/shared"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type HTTPHandler struct {
+	service *Service
+	logger  *slog.Logger
+}
+
+func NewHTTPHandler(service *Service, logger *slog.Logger) *HTTPHandler {
+	return &HTTPHandler{
+		service: service,
+		logger:  logger.With(slog.String("domain", "library")),
+	}
+}
+
+func (h *HTTPHandler) RegisterRoutes(router *shared.Router) {
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/users/{id}/history",
+		Tag:      "library",
+		Summary:  "A user's watch history, newest first",
+		Query:    shared.PaginationParams,
+		Response: WatchHistoryResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound},
+		Auth:     true,
+	}, h.getHistory)
+	router.Handle(shared.Route{
+		Method:   http.MethodPost,
+		Path:     "/users/{id}/history",
+		Tag:      "library",
+		Summary:  "Mark a movie as watched",
+		Request:  MarkAsWatchedRequest{},
+		Response: WatchHistory{},
+		Status:   http.StatusCreated,
+		Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusUnprocessableEntity},
+		Auth:     true,
+	}, h.markAsWatched)
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/users/{id}/stats",
+		Tag:      "library",
+		Summary:  "Totals of movies and minutes watched",
+		Response: WatchingStats{},
+		Errors:   []int{http.StatusForbidden, http.StatusNotFound},
+		Auth:     true,
+	}, h.getStats)
+}
+
+// MarkAsWatchedRequest records a viewing; watched_at defaults to now and a
+// zero duration to the movie's runtime.
+type MarkAsWatchedRequest struct {
+	MovieID   string    `json:"movie_id"`
+	WatchedAt time.Time `json:"watched_at,omitempty"`
+	Duration  int       `json:"duration_watched,omitempty"`
+}
+
+type WatchHistoryResponse struct {
+	Data       []*WatchHistory   `json:"data"`
+	Pagination shared.Pagination `json:"pagination"`
+}
+
+func (h *HTTPHandler) getHistory(w http.ResponseWriter, r *http.Request) {
+	page, ok := shared.ParsePageRequest(w, r)
+	if !ok {
+		return
+	}
+
+	history, err := h.service.GetWatchHistory(r.Context(), r.PathValue("id"), page)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, WatchHistoryResponse{
+		Data:       history.Items,
+		Pagination: shared.PaginationOf(history, page),
+	})
+}
+
+func (h *HTTPHandler) markAsWatched(w http.ResponseWriter, r *http.Request) {
+	var request MarkAsWatchedRequest
+	if err := shared.DecodeJSON(w, r, &request); err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	fields := make(map[string]string)
+	if !primitive.IsValidObjectID(request.MovieID) {
+		fields["movie_id"] = "must be a 24 character hex ObjectID"
+	}
+	if request.WatchedAt.After(time.Now()) {
+		fields["watched_at"] = "must not be in the future"
+	}
+	if request.Duration < 0 {
+		fields["duration_watched"] = "must not be negative"
+	}
+	if len(fields) > 0 {
+		shared.WriteError(w, r, http.StatusUnprocessableEntity, "invalid watch history entry", fields)
+		return
+	}
+
+	history, err := h.service.MarkAsWatched(r.Context(), r.PathValue("id"), request.MovieID, request.WatchedAt, request.Duration)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusCreated, history)
+}
+
+func (h *HTTPHandler) getStats(w http.ResponseWriter, r *http.Request) {
+	stats, err := h.service.GetWatchingStats(r.Context(), r.PathValue("id"))
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, stats)
+}
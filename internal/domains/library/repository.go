@@ -3,18 +3,24 @@ package library
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"time"
 
+	"event-driven-go/internal/shared"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
 type Repository struct {
-	db *gorm.DB
+	db     *gorm.DB
+	logger *slog.Logger
 }
 
-func NewRepository(db *gorm.DB) *Repository {
-	return &Repository{db: db}
+func NewRepository(db *gorm.DB, logger *slog.Logger) *Repository {
+	return &Repository{
+		db:     db,
+		logger: logger.With(slog.String("repository", "library")),
+	}
 }
 
 func (r *Repository) AddWatchHistory(ctx context.Context, userID, movieID string, watchedAt time.Time, duration int) (*WatchHistory, error) {
@@ -30,25 +36,39 @@ func (r *Repository) AddWatchHistory(ctx context.Context, userID, movieID string
 	if result.Error != nil {
 		return nil, fmt.Errorf("failed to add watch history: %w", result.Error)
 	}
+	r.logger.DebugContext(ctx, "watch history added",
+		slog.String("user_id", userID),
+		slog.String("movie_id", movieID),
+	)
 
 	return history, nil
 }
 
-func (r *Repository) GetUserWatchHistory(ctx context.Context, userID string, limit, offset int) ([]*WatchHistory, error) {
-	var history []*WatchHistory
+// historyCursor is the sort key of the watch history, newest first.
+type historyCursor struct {
+	WatchedAt time.Time `json:"watched_at"`
+	ID        string    `json:"id"`
+}
 
-	result := r.db.WithContext(ctx).
-		Where("user_id = ?", userID).
-		Order("watched_at DESC").
-		Limit(limit).
-		Offset(offset).
+func (r *Repository) GetUserWatchHistory(ctx context.Context, userID string, page shared.PageRequest) (*shared.Page[*WatchHistory], error) {
+	var key historyCursor
+	direction, err := page.Seek(&key)
+	if err != nil {
+		return nil, err
+	}
+
+	var history []*WatchHistory
+	result := shared.KeysetPage(r.db.WithContext(ctx).Where("user_id = ?", userID), page, direction,
+		[]string{"watched_at", "id"}, key.WatchedAt, key.ID).
 		Find(&history)
 
 	if result.Error != nil {
 		return nil, fmt.Errorf("failed to get watch history: %w", result.Error)
 	}
 
-	return history, nil
+	return shared.NewPage(history, page, direction, func(entry *WatchHistory) interface{} {
+		return historyCursor{WatchedAt: entry.WatchedAt, ID: entry.ID}
+	}), nil
 }
 
 func (r *Repository) GetMovieWatchCount(ctx context.Context, movieID string) (int, error) {
@@ -81,6 +101,23 @@ func (r *Repository) HasUserWatchedMovie(ctx context.Context, userID, movieID st
 	return count > 0, nil
 }
 
+// GetWatchedMovieIDs returns which of the movies the user has watched.
+func (r *Repository) GetWatchedMovieIDs(ctx context.Context, userID string, movieIDs []string) ([]string, error) {
+	var watched []string
+
+	result := r.db.WithContext(ctx).
+		Model(&WatchHistory{}).
+		Where("user_id = ? AND movie_id IN ?", userID, movieIDs).
+		Distinct().
+		Pluck("movie_id", &watched)
+
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to check watch history: %w", result.Error)
+	}
+
+	return watched, nil
+}
+
 func (r *Repository) GetRecentlyWatchedMovies(ctx context.Context, limit int) ([]*WatchHistory, error) {
 	var history []*WatchHistory
 
@@ -97,42 +134,70 @@ func (r *Repository) GetRecentlyWatchedMovies(ctx context.Context, limit int) ([
 	return history, nil
 }
 
-func (r *Repository) GetWatchingStats(ctx context.Context, userID string) (map[string]interface{}, error) {
-	stats := make(map[string]interface{})
+func (r *Repository) GetWatchingStats(ctx context.Context, userID string) (*WatchingStats, error) {
+	stats := &WatchingStats{}
 
-	var totalMoviesWatched int64
 	if err := r.db.WithContext(ctx).
 		Model(&WatchHistory{}).
 		Where("user_id = ?", userID).
-		Count(&totalMoviesWatched).Error; err != nil {
+		Count(&stats.TotalMoviesWatched).Error; err != nil {
 		return nil, fmt.Errorf("failed to get total count: %w", err)
 	}
-	stats["total_movies_watched"] = totalMoviesWatched
 
-	var totalWatchTime int64
 	if err := r.db.WithContext(ctx).
 		Model(&WatchHistory{}).
 		Where("user_id = ?", userID).
 		Select("COALESCE(SUM(duration), 0)").
-		Scan(&totalWatchTime).Error; err != nil {
+		Scan(&stats.TotalMinutes).Error; err != nil {
 		return nil, fmt.Errorf("failed to get total duration: %w", err)
 	}
-	stats["total_minutes"] = totalWatchTime
-	stats["total_hours"] = float64(totalWatchTime) / 60.0
+	stats.TotalHours = float64(stats.TotalMinutes) / 60.0
 
-	var totalMoviesWatchedThisMonth int64
-	startOfMonth := time.Now().AddDate(0, 0, -time.Now().Day()+1)
+	now := time.Now()
+	startOfMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
 	if err := r.db.WithContext(ctx).
 		Model(&WatchHistory{}).
 		Where("user_id = ? AND watched_at >= ?", userID, startOfMonth).
-		Count(&totalMoviesWatchedThisMonth).Error; err != nil {
+		Count(&stats.TotalMoviesWatchedThisMonth).Error; err != nil {
 		return nil, fmt.Errorf("failed to get this month count: %w", err)
 	}
-	stats["total_movies_watched_this_month"] = totalMoviesWatchedThisMonth
 
 	return stats, nil
 }
 
-func (r *Repository) AutoMigrate() error {
-	return r.db.AutoMigrate(&WatchHistory{})
+// DeleteUserData removes all watch history belonging to a user.
+func (r *Repository) DeleteUserData(ctx context.Context, userID string) error {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&WatchHistory{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete user watch history: %w", result.Error)
+	}
+	r.logger.DebugContext(ctx, "user watch history deleted",
+		slog.String("user_id", userID),
+		slog.Int64("rows", result.RowsAffected),
+	)
+
+	return nil
+}
+
+// MergeMovieReferences moves the watch history of merged movies to the
+// surviving movie.
+func (r *Repository) MergeMovieReferences(ctx context.Context, survivorID string, duplicateIDs []string) error {
+	result := r.db.WithContext(ctx).
+		Unscoped().
+		Model(&WatchHistory{}).
+		Where("movie_id IN ?", duplicateIDs).
+		Update("movie_id", survivorID)
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to move watch history: %w", result.Error)
+	}
+	r.logger.DebugContext(ctx, "watch history moved to merged movie",
+		slog.String("movie_id", survivorID),
+		slog.Int64("rows", result.RowsAffected),
+	)
+
+	return nil
 }
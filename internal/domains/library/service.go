@@ -2,51 +2,133 @@ package library
 
 import (
 	"context"
-	"fmt"
+	"log/slog"
 	"time"
 
+	"event-driven-go/internal/domains/user"
 	"event-driven-go/internal/shared"
+	schema "github.com/nameteos/my-movies-db-schema/mongodb"
 )
 
+// UserLookup and MovieLookup are the parts of the user and movies services the
+// library needs to check that a history entry points at real records.
+type UserLookup interface {
+	GetUserByID(ctx context.Context, id string) (*user.User, error)
+}
+
+type MovieLookup interface {
+	GetMovieByID(ctx context.Context, id string) (*schema.Movie, error)
+}
+
 type Service struct {
-	eventBus *shared.EventBus
+	repository *Repository
+	users      UserLookup
+	movies     MovieLookup
+	eventBus   *shared.EventBus
+	logger     *slog.Logger
 }
 
-func NewService(eventBus *shared.EventBus) *Service {
+func NewService(repository *Repository, users UserLookup, movies MovieLookup, eventBus *shared.EventBus, logger *slog.Logger) *Service {
 	return &Service{
-		eventBus: eventBus,
+		repository: repository,
+		users:      users,
+		movies:     movies,
+		eventBus:   eventBus,
+		logger:     logger.With(slog.String("domain", "library")),
 	}
 }
 
-func (s *Service) MarkAsWatched(ctx context.Context, userID, movieID, title string, watchedAt time.Time, duration int) error {
+// MarkAsWatched records a viewing in the user's history. A zero duration is
+// taken to mean the whole movie was watched.
+func (s *Service) MarkAsWatched(ctx context.Context, userID, movieID string, watchedAt time.Time, duration int) (*WatchHistory, error) {
 	// todo implement validator?
 	if userID == "" {
-		return fmt.Errorf("user ID cannot be empty")
+		return nil, shared.NewFieldError("user_id", "must not be empty")
 	}
 	if movieID == "" {
-		return fmt.Errorf("movie ID cannot be empty")
+		return nil, shared.NewFieldError("movie_id", "must not be empty")
+	}
+	if duration < 0 {
+		return nil, shared.NewFieldError("duration_watched", "must not be negative")
+	}
+	if err := shared.Authorize(ctx, shared.ActionModifyUserData, userID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.users.GetUserByID(ctx, userID); err != nil {
+		return nil, err
 	}
-	if title == "" {
-		return fmt.Errorf("movie title cannot be empty")
+	movie, err := s.movies.GetMovieByID(ctx, movieID)
+	if err != nil {
+		return nil, err
+	}
+
+	if watchedAt.IsZero() {
+		watchedAt = time.Now()
+	}
+	if duration == 0 {
+		duration = movie.Runtime
 	}
 
 	// todo:
-	// - Store watch history in database
 	// - listener?- Remove from watchlist if present
 	// - listener?- Update user's viewing statistics
 
+	history, err := s.repository.AddWatchHistory(ctx, userID, movieID, watchedAt, duration)
+	if err != nil {
+		return nil, err
+	}
+
 	// Create and publish the event
-	event := NewMovieWatchedEvent(userID, movieID, title, watchedAt, duration)
+	event := NewMovieWatchedEvent(userID, movieID, movie.Title, watchedAt, duration)
 
 	if err := s.eventBus.Publish(ctx, event); err != nil {
-		return fmt.Errorf("failed to publish movie watched event: %w", err)
+		// Log error but don't fail the operation since the history entry was stored
+		s.logger.WarnContext(ctx, "failed to publish movie watched event", slog.Any("error", err))
+	}
+
+	return history, nil
+}
+
+// GetWatchHistory returns a page of the user's history, most recently watched first.
+func (s *Service) GetWatchHistory(ctx context.Context, userID string, page shared.PageRequest) (*shared.Page[*WatchHistory], error) {
+	if userID == "" {
+		return nil, shared.NewFieldError("user_id", "must not be empty")
+	}
+	if err := shared.Authorize(ctx, shared.ActionReadUserData, userID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.users.GetUserByID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return s.repository.GetUserWatchHistory(ctx, userID, page.WithDefaults())
+}
+
+// WatchedMovieIDs returns which of the movies are in the user's history.
+func (s *Service) WatchedMovieIDs(ctx context.Context, userID string, movieIDs []string) ([]string, error) {
+	if userID == "" {
+		return nil, shared.NewFieldError("user_id", "must not be empty")
+	}
+	if len(movieIDs) == 0 {
+		return nil, nil
 	}
 
-	return nil
+	return s.repository.GetWatchedMovieIDs(ctx, userID, movieIDs)
 }
 
-func (s *Service) GetWatchHistory(ctx context.Context, userID string) error {
+func (s *Service) GetWatchingStats(ctx context.Context, userID string) (*WatchingStats, error) {
+	if userID == "" {
+		return nil, shared.NewFieldError("user_id", "must not be empty")
+	}
+	if err := shared.Authorize(ctx, shared.ActionReadUserData, userID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.users.GetUserByID(ctx, userID); err != nil {
+		return nil, err
+	}
 
-	// TODO: Implement
-	return nil
+	return s.repository.GetWatchingStats(ctx, userID)
 }
@@ -14,11 +14,18 @@ type WatchHistory struct {
 	Duration  int       `json:"duration_watched" db:"duration_watched" gorm:"default:0"` // How long they watched in minutes
 
 	// GORM fields
-	CreatedAt time.Time      `gorm:"autoCreateTime"`
-	UpdatedAt time.Time      `gorm:"autoUpdateTime"`
-	DeletedAt gorm.DeletedAt `gorm:"index"` // Soft delete support
+	CreatedAt time.Time      `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"` // Soft delete support
 }
 
 func (WatchHistory) TableName() string {
 	return "watch_history"
 }
+
+type WatchingStats struct {
+	TotalMoviesWatched          int64   `json:"total_movies_watched"`
+	TotalMinutes                int64   `json:"total_minutes"`
+	TotalHours                  float64 `json:"total_hours"`
+	TotalMoviesWatchedThisMonth int64   `json:"total_movies_watched_this_month"`
+}
@@ -0,0 +1,274 @@
+package movies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"event-driven-go/internal/shared"
+	schema "github.com/nameteos/my-movies-db-schema/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	MaxCollectionMovies = 200
+
+	maxCollectionNameLength = 200
+)
+
+var collectionOrder = []sortField{{"name", false}, {"_id", false}}
+
+// Collection is an ordered set of movies, such as a trilogy or the films of a
+// franchise, in the order they are meant to be watched.
+type Collection struct {
+	ID          primitive.ObjectID   `json:"id" bson:"_id,omitempty"`
+	Name        string               `json:"name" bson:"name"`
+	Description string               `json:"description,omitempty" bson:"description,omitempty"`
+	MovieIDs    []primitive.ObjectID `json:"movie_ids" bson:"movie_ids"`
+	CreatedAt   time.Time            `json:"created_at" bson:"created_at"`
+	UpdatedAt   time.Time            `json:"updated_at" bson:"updated_at"`
+
+	// Movies are the members in order, filled in when a single collection is
+	// read. Members that have left the catalog are missing.
+	Movies []*schema.Movie `json:"movies,omitempty" bson:"-"`
+}
+
+// CollectionInput holds the editable fields of a collection. The movies are
+// given in order.
+type CollectionInput struct {
+	Name        string   `json:"name" openapi:"required"`
+	Description string   `json:"description,omitempty"`
+	MovieIDs    []string `json:"movie_ids"`
+}
+
+// parse validates the input and returns the member IDs, with the problems by
+// field.
+func (in *CollectionInput) parse() ([]primitive.ObjectID, map[string]string) {
+	fields := make(map[string]string)
+	in.Name = strings.TrimSpace(in.Name)
+	in.Description = strings.TrimSpace(in.Description)
+
+	if in.Name == "" {
+		fields["name"] = "must not be empty"
+	} else if len(in.Name) > maxCollectionNameLength {
+		fields["name"] = fmt.Sprintf("must be at most %d characters", maxCollectionNameLength)
+	}
+	if len(in.MovieIDs) > MaxCollectionMovies {
+		fields["movie_ids"] = fmt.Sprintf("must have at most %d movies", MaxCollectionMovies)
+		return nil, fields
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(in.MovieIDs))
+	for i, id := range in.MovieIDs {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			fields[fmt.Sprintf("movie_ids[%d]", i)] = "must be a 24 character hex ObjectID"
+			continue
+		}
+		if slices.Contains(ids, objectID) {
+			fields[fmt.Sprintf("movie_ids[%d]", i)] = "repeats an earlier movie"
+			continue
+		}
+		ids = append(ids, objectID)
+	}
+
+	return ids, fields
+}
+
+// collectionCursor is the sort key of a collection in collectionOrder.
+type collectionCursor struct {
+	Name string             `json:"name"`
+	ID   primitive.ObjectID `json:"id"`
+}
+
+func collectionCursorOf(collection *Collection) interface{} {
+	return collectionCursor{Name: collection.Name, ID: collection.ID}
+}
+
+func (r *MongoRepository) CreateCollection(ctx context.Context, collection *Collection) (*Collection, error) {
+	collection.ID = primitive.NewObjectID()
+	collection.CreatedAt = time.Now()
+	collection.UpdatedAt = collection.CreatedAt
+
+	if _, err := r.collections.InsertOne(ctx, collection); err != nil {
+		return nil, fmt.Errorf("failed to create collection: %w", err)
+	}
+	r.logger.DebugContext(ctx, "collection created", slog.String("collection_id", collection.ID.Hex()))
+
+	return collection, nil
+}
+
+func (r *MongoRepository) GetCollectionByID(ctx context.Context, id string) (*Collection, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCollectionID, err)
+	}
+
+	var collection Collection
+	if err := r.collections.FindOne(ctx, bson.M{"_id": objectID}).Decode(&collection); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrCollectionNotFound
+		}
+		return nil, fmt.Errorf("failed to get collection: %w", err)
+	}
+
+	return &collection, nil
+}
+
+// ListCollections pages through the collections by name.
+func (r *MongoRepository) ListCollections(ctx context.Context, page shared.PageRequest) (*shared.Page[*Collection], error) {
+	var key collectionCursor
+	direction, err := page.Seek(&key)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := options.Find().
+		SetLimit(int64(page.Limit + 1)).
+		SetSort(keysetSort(collectionOrder, direction))
+	if direction == shared.FromStart {
+		opts.SetSkip(int64(page.Offset))
+	}
+	collections, err := r.findCollections(ctx, keysetFilter(collectionOrder, direction, key.Name, key.ID), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.NewPage(collections, page, direction, collectionCursorOf), nil
+}
+
+// GetMovieCollections returns the collections a movie belongs to, by name.
+func (r *MongoRepository) GetMovieCollections(ctx context.Context, movieID string) ([]*Collection, error) {
+	objectID, err := primitive.ObjectIDFromHex(movieID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
+	}
+
+	opts := options.Find().SetSort(keysetSort(collectionOrder, shared.After))
+	collections, err := r.findCollections(ctx, bson.M{"movie_ids": objectID}, opts)
+	if err != nil {
+		return nil, err
+	}
+	if collections == nil {
+		collections = []*Collection{}
+	}
+	return collections, nil
+}
+
+func (r *MongoRepository) findCollections(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*Collection, error) {
+	cursor, err := r.collections.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find collections: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var collections []*Collection
+	if err := cursor.All(ctx, &collections); err != nil {
+		return nil, fmt.Errorf("failed to decode collections: %w", err)
+	}
+	return collections, nil
+}
+
+// UpdateCollection replaces the name, description and members of the
+// collection.
+func (r *MongoRepository) UpdateCollection(ctx context.Context, collection *Collection) (*Collection, error) {
+	update := bson.M{"$set": bson.M{
+		"name":        collection.Name,
+		"description": collection.Description,
+		"movie_ids":   collection.MovieIDs,
+		"updated_at":  time.Now(),
+	}}
+
+	var updated Collection
+	err := r.collections.FindOneAndUpdate(ctx, bson.M{"_id": collection.ID}, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&updated)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrCollectionNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update collection: %w", err)
+	}
+
+	return &updated, nil
+}
+
+func (r *MongoRepository) DeleteCollection(ctx context.Context, id string) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidCollectionID, err)
+	}
+
+	result, err := r.collections.DeleteOne(ctx, bson.M{"_id": objectID})
+	if err != nil {
+		return fmt.Errorf("failed to delete collection: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return ErrCollectionNotFound
+	}
+
+	return nil
+}
+
+// RemoveFromCollections takes a deleted movie out of every collection.
+func (r *MongoRepository) RemoveFromCollections(ctx context.Context, movieID string) error {
+	objectID, err := primitive.ObjectIDFromHex(movieID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
+	}
+
+	update := bson.M{
+		"$pull": bson.M{"movie_ids": objectID},
+		"$set":  bson.M{"updated_at": time.Now()},
+	}
+	if _, err := r.collections.UpdateMany(ctx, bson.M{"movie_ids": objectID}, update); err != nil {
+		return fmt.Errorf("failed to remove movie from collections: %w", err)
+	}
+	return nil
+}
+
+// MergeCollectionMembers replaces merged movies with the surviving movie in
+// the collections, which takes the place of the first of them.
+func (r *MongoRepository) MergeCollectionMembers(ctx context.Context, survivorID string, duplicateIDs []string) error {
+	survivor, err := primitive.ObjectIDFromHex(survivorID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
+	}
+	merged := []primitive.ObjectID{survivor}
+	for _, id := range duplicateIDs {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
+		}
+		merged = append(merged, objectID)
+	}
+
+	collections, err := r.findCollections(ctx, bson.M{"movie_ids": bson.M{"$in": merged[1:]}}, options.Find())
+	if err != nil {
+		return err
+	}
+	for _, collection := range collections {
+		members := make([]primitive.ObjectID, 0, len(collection.MovieIDs))
+		for _, id := range collection.MovieIDs {
+			if slices.Contains(merged, id) {
+				id = survivor
+			}
+			if !slices.Contains(members, id) {
+				members = append(members, id)
+			}
+		}
+
+		update := bson.M{"$set": bson.M{"movie_ids": members, "updated_at": time.Now()}}
+		if _, err := r.collections.UpdateByID(ctx, collection.ID, update); err != nil {
+			return fmt.Errorf("failed to merge collection members: %w", err)
+		}
+	}
+	return nil
+}
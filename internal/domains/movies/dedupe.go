@@ -0,0 +1,131 @@
+package movies
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"golang.org/x/text/unicode/norm"
+)
+
+// runtimeTolerance is how many minutes the runtimes of duplicates may differ
+// by, as catalogs count credits and versions differently.
+const runtimeTolerance = 5
+
+// MovieFingerprint is what duplicate detection compares movies by.
+type MovieFingerprint struct {
+	ID          primitive.ObjectID `json:"id" bson:"_id"`
+	Title       string             `json:"title" bson:"title"`
+	ReleaseDate string             `json:"release_date" bson:"releasedate"`
+	Runtime     int                `json:"runtime" bson:"runtime"`
+	ExternalIDs `json:"external_ids" bson:",inline"`
+	CreatedAt   time.Time `json:"created_at" bson:"created_at"`
+}
+
+// DuplicateGroup is a set of movies that look like the same film. The first
+// is the one the others would be merged into.
+type DuplicateGroup struct {
+	Movies []*MovieFingerprint `json:"movies"`
+}
+
+// duplicateKey groups movies by normalized title and release year.
+type duplicateKey struct {
+	title string
+	year  string
+}
+
+func duplicateKeyOf(movie *MovieFingerprint) (duplicateKey, bool) {
+	title := normalizeTitle(movie.Title)
+	if title == "" || len(movie.ReleaseDate) < 4 {
+		return duplicateKey{}, false
+	}
+	return duplicateKey{title: title, year: movie.ReleaseDate[:4]}, true
+}
+
+// normalizeTitle folds a title and drops a leading English article, so that
+// "The Matrix" and "matrix" compare equal.
+func normalizeTitle(title string) string {
+	words := strings.Fields(foldTitle(title))
+	if len(words) > 1 && (words[0] == "the" || words[0] == "a" || words[0] == "an") {
+		words = words[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+// foldTitle lowercases a title, strips accents and apostrophes and turns
+// other punctuation into spaces, so that "Amélie" and "amelie" and "Bug's"
+// and "bugs" compare equal.
+func foldTitle(title string) string {
+	var folded strings.Builder
+	for _, r := range norm.NFKD.String(strings.ToLower(title)) {
+		switch {
+		case unicode.Is(unicode.Mn, r), r == '\'', r == '’':
+			// Accents split off by the decomposition and apostrophes
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			folded.WriteRune(r)
+		default:
+			folded.WriteRune(' ')
+		}
+	}
+	return strings.Join(strings.Fields(folded.String()), " ")
+}
+
+// sameFilm reports whether two movies with the same title and year are the
+// same film: their runtimes are close or unknown, and no catalog gives them
+// different IDs.
+func sameFilm(a, b *MovieFingerprint) bool {
+	if a.Runtime > 0 && b.Runtime > 0 && abs(a.Runtime-b.Runtime) > runtimeTolerance {
+		return false
+	}
+	return !a.ExternalIDs.conflicts(b.ExternalIDs)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// duplicateGroups splits the movies sharing a title and year into groups of
+// the same film, keeping those with more than one movie.
+func duplicateGroups(candidates []*MovieFingerprint) []DuplicateGroup {
+	var groups []DuplicateGroup
+	for _, movie := range candidates {
+		joined := false
+		for i, group := range groups {
+			if !slices.ContainsFunc(group.Movies, func(member *MovieFingerprint) bool { return !sameFilm(member, movie) }) {
+				groups[i].Movies = append(group.Movies, movie)
+				joined = true
+				break
+			}
+		}
+		if !joined {
+			groups = append(groups, DuplicateGroup{Movies: []*MovieFingerprint{movie}})
+		}
+	}
+
+	duplicates := groups[:0]
+	for _, group := range groups {
+		if len(group.Movies) > 1 {
+			slices.SortFunc(group.Movies, survivorOrder)
+			duplicates = append(duplicates, group)
+		}
+	}
+	return duplicates
+}
+
+// survivorOrder puts the movie to keep first: the one with the most external
+// IDs, then the oldest.
+func survivorOrder(a, b *MovieFingerprint) int {
+	if c := cmp.Compare(b.ExternalIDs.count(), a.ExternalIDs.count()); c != 0 {
+		return c
+	}
+	if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+		return c
+	}
+	return strings.Compare(a.ID.Hex(), b.ID.Hex())
+}
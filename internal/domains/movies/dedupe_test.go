@@ -0,0 +1,201 @@
+package movies
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestNormalizeTitle(t *testing.T) {
+	tests := []struct {
+		a, b  string
+		equal bool
+	}{
+		{a: "The Matrix", b: "matrix", equal: true},
+		{a: "Amélie", b: "AMELIE", equal: true},
+		{a: "Léon: The Professional", b: "Leon - the professional", equal: true},
+		{a: "A Bug's Life", b: "Bugs Life", equal: true},
+		{a: "L’Atalante", b: "latalante", equal: true},
+		{a: "An American Werewolf in London", b: "American Werewolf in London", equal: true},
+		{a: "Seven Samurai", b: "Seven  Samurai!", equal: true},
+		// A lone article is the whole title
+		{a: "The", b: "", equal: false},
+		{a: "Them", b: "M", equal: false},
+		{a: "Alien", b: "Aliens", equal: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"/"+tt.b, func(t *testing.T) {
+			a, b := normalizeTitle(tt.a), normalizeTitle(tt.b)
+			if (a == b) != tt.equal {
+				t.Errorf("got %q and %q, want equal %t", a, b, tt.equal)
+			}
+		})
+	}
+}
+
+func TestFoldTitle(t *testing.T) {
+	tests := map[string]string{
+		"Amélie":                         "amelie",
+		"Crouching Tiger, Hidden Dragon": "crouching tiger hidden dragon",
+		"Schindler’s List":               "schindlers list",
+		"  The  Thing  ":                 "the thing",
+		"Ｗall·E":                         "wall e",
+	}
+
+	for title, want := range tests {
+		if got := foldTitle(title); got != want {
+			t.Errorf("foldTitle(%q) = %q, want %q", title, got, want)
+		}
+	}
+}
+
+func TestDuplicateKeyOf(t *testing.T) {
+	tests := []struct {
+		name  string
+		movie MovieFingerprint
+		want  duplicateKey
+		ok    bool
+	}{
+		{name: "full date", movie: MovieFingerprint{Title: "The Matrix", ReleaseDate: "1999-03-31"}, want: duplicateKey{title: "matrix", year: "1999"}, ok: true},
+		{name: "year only", movie: MovieFingerprint{Title: "Matrix", ReleaseDate: "1999"}, want: duplicateKey{title: "matrix", year: "1999"}, ok: true},
+		{name: "no date", movie: MovieFingerprint{Title: "The Matrix"}},
+		{name: "no title", movie: MovieFingerprint{Title: "?!", ReleaseDate: "1999-03-31"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := duplicateKeyOf(&tt.movie)
+			if ok != tt.ok || got != tt.want {
+				t.Errorf("got %+v, %t, want %+v, %t", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+// fingerprint returns a movie with the given IDs and creation day.
+func fingerprint(id string, runtime int, ids ExternalIDs, day int) *MovieFingerprint {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		panic(err)
+	}
+	return &MovieFingerprint{
+		ID:          objectID,
+		Title:       "The Thing",
+		ReleaseDate: "1982-06-25",
+		Runtime:     runtime,
+		ExternalIDs: ids,
+		CreatedAt:   time.Date(2024, 1, day, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// groupIDs returns the IDs of each group's movies in order.
+func groupIDs(groups []DuplicateGroup) [][]string {
+	ids := make([][]string, len(groups))
+	for i, group := range groups {
+		for _, movie := range group.Movies {
+			ids[i] = append(ids[i], movie.ID.Hex()[22:])
+		}
+	}
+	return ids
+}
+
+func TestDuplicateGroups(t *testing.T) {
+	const (
+		a = "0000000000000000000000a1"
+		b = "0000000000000000000000b2"
+		c = "0000000000000000000000c3"
+		d = "0000000000000000000000d4"
+	)
+
+	tests := []struct {
+		name       string
+		candidates []*MovieFingerprint
+		want       [][]string
+	}{
+		{
+			name: "runtimes within the tolerance",
+			candidates: []*MovieFingerprint{
+				fingerprint(a, 109, ExternalIDs{}, 1),
+				fingerprint(b, 109+runtimeTolerance, ExternalIDs{}, 2),
+			},
+			want: [][]string{{"a1", "b2"}},
+		},
+		{
+			name: "runtimes past the tolerance",
+			candidates: []*MovieFingerprint{
+				fingerprint(a, 109, ExternalIDs{}, 1),
+				fingerprint(b, 110+runtimeTolerance, ExternalIDs{}, 2),
+			},
+		},
+		{
+			name: "unknown runtime",
+			candidates: []*MovieFingerprint{
+				fingerprint(a, 0, ExternalIDs{}, 1),
+				fingerprint(b, 109, ExternalIDs{}, 2),
+			},
+			want: [][]string{{"a1", "b2"}},
+		},
+		{
+			name: "conflicting external IDs",
+			candidates: []*MovieFingerprint{
+				fingerprint(a, 109, ExternalIDs{IMDb: "tt0084787"}, 1),
+				fingerprint(b, 109, ExternalIDs{IMDb: "tt0084788"}, 2),
+			},
+		},
+		{
+			name: "external IDs from different catalogs",
+			candidates: []*MovieFingerprint{
+				fingerprint(a, 109, ExternalIDs{IMDb: "tt0084787"}, 1),
+				fingerprint(b, 109, ExternalIDs{TMDb: 1091}, 2),
+			},
+			want: [][]string{{"a1", "b2"}},
+		},
+		{
+			name: "a movie matching only part of a group",
+			candidates: []*MovieFingerprint{
+				fingerprint(a, 105, ExternalIDs{}, 1),
+				fingerprint(b, 109, ExternalIDs{}, 2),
+				// Close to b but not to a
+				fingerprint(c, 113, ExternalIDs{}, 3),
+				fingerprint(d, 112, ExternalIDs{}, 4),
+			},
+			want: [][]string{{"a1", "b2"}, {"c3", "d4"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := groupIDs(duplicateGroups(tt.candidates))
+			if !slices.EqualFunc(got, tt.want, slices.Equal) {
+				t.Errorf("got groups %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSurvivorOrder(t *testing.T) {
+	const (
+		a = "0000000000000000000000a1"
+		b = "0000000000000000000000b2"
+		c = "0000000000000000000000c3"
+		d = "0000000000000000000000d4"
+	)
+
+	candidates := []*MovieFingerprint{
+		// The oldest, but with a single ID
+		fingerprint(a, 109, ExternalIDs{TMDb: 1091}, 1),
+		// Same IDs and age as c, so the lower ID goes first
+		fingerprint(d, 109, ExternalIDs{IMDb: "tt0084787", TMDb: 1091}, 3),
+		fingerprint(c, 109, ExternalIDs{IMDb: "tt0084787", TMDb: 1091}, 3),
+		fingerprint(b, 109, ExternalIDs{IMDb: "tt0084787", TMDb: 1091}, 2),
+	}
+
+	got := groupIDs(duplicateGroups(candidates))
+	want := [][]string{{"b2", "c3", "d4", "a1"}}
+	if !slices.EqualFunc(got, want, slices.Equal) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
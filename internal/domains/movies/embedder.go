@@ -0,0 +1,281 @@
+package movies
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"math"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"event-driven-go/internal/shared"
+	schema "github.com/nameteos/my-movies-db-schema/mongodb"
+)
+
+// EmbeddingDimensions is the size of the vectors the movie vector index is
+// created for, which every embedder has to produce.
+const EmbeddingDimensions = 1536
+
+// Embedder turns texts into vectors whose cosine similarity reflects how
+// alike the texts are.
+type Embedder interface {
+	// Model names the vectors; only those of the same model are comparable.
+	Model() string
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// NewEmbedder returns the embedder the configuration selects.
+func NewEmbedder(config shared.EmbeddingsConfig) (Embedder, error) {
+	switch config.Provider {
+	case "", "local":
+		return NewHashingEmbedder(), nil
+	case "remote":
+		if config.URL == "" || config.Model == "" {
+			return nil, errors.New("a remote embedder needs EMBEDDINGS_URL and EMBEDDINGS_MODEL")
+		}
+		return NewRemoteEmbedder(config), nil
+	default:
+		return nil, fmt.Errorf("unknown embeddings provider %q, expected local or remote", config.Provider)
+	}
+}
+
+// stopWords are too common in English to tell movies apart.
+var stopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "are": true, "as": true, "at": true, "be": true, "but": true,
+	"by": true, "for": true, "from": true, "has": true, "he": true, "her": true, "his": true, "in": true,
+	"into": true, "is": true, "it": true, "its": true, "of": true, "on": true, "or": true, "she": true,
+	"that": true, "the": true, "their": true, "they": true, "this": true, "to": true, "was": true,
+	"when": true, "who": true, "with": true,
+}
+
+// HashingEmbedder embeds texts locally and deterministically by hashing their
+// words and word pairs into the vector, weighted by the logarithm of their
+// count. It needs no service or corpus, which makes it the default for
+// development and tests, but it only finds texts sharing words.
+type HashingEmbedder struct{}
+
+func NewHashingEmbedder() *HashingEmbedder {
+	return &HashingEmbedder{}
+}
+
+func (e *HashingEmbedder) Model() string {
+	return "hashing-v1"
+}
+
+func (e *HashingEmbedder) Embed(_ context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, 0, len(texts))
+	for _, text := range texts {
+		vectors = append(vectors, hashText(text))
+	}
+	return vectors, nil
+}
+
+func hashText(text string) []float32 {
+	var words []string
+	for _, word := range strings.Fields(foldTitle(text)) {
+		if len(word) > 1 && !stopWords[word] {
+			words = append(words, word)
+		}
+	}
+
+	counts := make(map[string]int)
+	for i, word := range words {
+		counts[word]++
+		if i > 0 {
+			counts[words[i-1]+" "+word]++
+		}
+	}
+
+	vector := make([]float32, EmbeddingDimensions)
+	for term, count := range counts {
+		hash := fnv.New64a()
+		hash.Write([]byte(term))
+		sum := hash.Sum64()
+
+		// The top bit signs the feature, so that colliding terms cancel out
+		// rather than add up
+		weight := 1 + math.Log(float64(count))
+		if sum>>63 == 1 {
+			weight = -weight
+		}
+		vector[sum%EmbeddingDimensions] += float32(weight)
+	}
+
+	return normalize(vector)
+}
+
+func normalize(vector []float32) []float32 {
+	var norm float64
+	for _, value := range vector {
+		norm += float64(value) * float64(value)
+	}
+	if norm == 0 {
+		return vector
+	}
+
+	scale := float32(1 / math.Sqrt(norm))
+	for i := range vector {
+		vector[i] *= scale
+	}
+	return vector
+}
+
+// RemoteEmbedder calls an embeddings API compatible with OpenAI's, asking
+// for vectors of EmbeddingDimensions.
+type RemoteEmbedder struct {
+	url    string
+	apiKey string
+	model  string
+	client *http.Client
+}
+
+func NewRemoteEmbedder(config shared.EmbeddingsConfig) *RemoteEmbedder {
+	return &RemoteEmbedder{
+		url:    config.URL,
+		apiKey: config.APIKey,
+		model:  config.Model,
+		client: &http.Client{Timeout: config.Timeout},
+	}
+}
+
+func (e *RemoteEmbedder) Model() string {
+	return e.model
+}
+
+type embeddingsRequest struct {
+	Model      string   `json:"model"`
+	Input      []string `json:"input"`
+	Dimensions int      `json:"dimensions"`
+}
+
+type embeddingsResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+func (e *RemoteEmbedder) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	body, err := json.Marshal(embeddingsRequest{Model: e.model, Input: texts, Dimensions: EmbeddingDimensions})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode embeddings request: %w", err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create embeddings request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if e.apiKey != "" {
+		request.Header.Set("Authorization", "Bearer "+e.apiKey)
+	}
+
+	response, err := e.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to call embeddings API: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(response.Body, 512))
+		return nil, fmt.Errorf("embeddings API returned %s: %s", response.Status, bytes.TrimSpace(message))
+	}
+
+	var embeddings embeddingsResponse
+	if err := json.NewDecoder(response.Body).Decode(&embeddings); err != nil {
+		return nil, fmt.Errorf("failed to decode embeddings response: %w", err)
+	}
+
+	vectors := make([][]float32, len(texts))
+	for _, data := range embeddings.Data {
+		if data.Index < 0 || data.Index >= len(texts) || len(data.Embedding) != EmbeddingDimensions {
+			return nil, fmt.Errorf("embeddings API returned an embedding of %d dimensions for input %d", len(data.Embedding), data.Index)
+		}
+		vectors[data.Index] = data.Embedding
+	}
+	for i, vector := range vectors {
+		if vector == nil {
+			return nil, fmt.Errorf("embeddings API returned no embedding for input %d", i)
+		}
+	}
+
+	return vectors, nil
+}
+
+var embeddingKeyPattern = regexp.MustCompile(`[^a-z0-9]+`)
+
+// embeddingKey is the key of a model's embedding in the embeddings of a
+// movie, which must not contain dots.
+func embeddingKey(model string) string {
+	return strings.Trim(embeddingKeyPattern.ReplaceAllString(strings.ToLower(model), "_"), "_")
+}
+
+// movieEmbeddingText is what a movie's embedding is computed from.
+func movieEmbeddingText(movie *schema.Movie) string {
+	parts := []string{movie.Title}
+	if movie.OriginalTitle != "" && movie.OriginalTitle != movie.Title {
+		parts = append(parts, movie.OriginalTitle)
+	}
+	for _, genre := range movie.Genres {
+		parts = append(parts, genre.Name)
+	}
+	parts = append(parts, movie.Tagline, movie.Overview)
+	return strings.Join(parts, "\n")
+}
+
+// embedMovie computes the embedding of a movie with the embedder.
+func embedMovie(ctx context.Context, embedder Embedder, movie *schema.Movie) (schema.EmbeddingObject, error) {
+	vectors, err := embedder.Embed(ctx, []string{movieEmbeddingText(movie)})
+	if err != nil {
+		return schema.EmbeddingObject{}, fmt.Errorf("failed to embed movie: %w", err)
+	}
+
+	return schema.EmbeddingObject{
+		Object:    "embedding",
+		Embedding: vectors[0],
+		Model:     embedder.Model(),
+	}, nil
+}
+
+// embedBatchSize is how many movies EmbedMissingMovies embeds per call of
+// the embedder.
+const embedBatchSize = 100
+
+// EmbedMissingMovies embeds the movies the embedder's model has not, such as
+// those written by an import or from before embeddings were computed. It
+// returns how many it embedded.
+func EmbedMissingMovies(ctx context.Context, repository Repository, embedder Embedder) (int, error) {
+	embedded := 0
+	for {
+		movies, err := repository.GetMoviesWithoutEmbedding(ctx, embedder.Model(), embedBatchSize)
+		if err != nil || len(movies) == 0 {
+			return embedded, err
+		}
+
+		texts := make([]string, 0, len(movies))
+		for _, movie := range movies {
+			texts = append(texts, movieEmbeddingText(movie))
+		}
+		vectors, err := embedder.Embed(ctx, texts)
+		if err != nil {
+			return embedded, fmt.Errorf("failed to embed movies: %w", err)
+		}
+
+		for i, movie := range movies {
+			err := repository.SetEmbedding(ctx, movie.ID.Hex(), schema.EmbeddingObject{
+				Object:    "embedding",
+				Embedding: vectors[i],
+				Model:     embedder.Model(),
+			})
+			if err != nil && !errors.Is(err, ErrMovieNotFound) {
+				return embedded, err
+			}
+			embedded++
+		}
+	}
+}
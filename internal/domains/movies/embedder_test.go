@@ -0,0 +1,100 @@
+package movies
+
+import (
+	"context"
+	"math"
+	"slices"
+	"testing"
+)
+
+func embed(t *testing.T, texts ...string) [][]float32 {
+	t.Helper()
+
+	vectors, err := NewHashingEmbedder().Embed(context.Background(), texts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vectors) != len(texts) {
+		t.Fatalf("got %d vectors for %d texts", len(vectors), len(texts))
+	}
+	return vectors
+}
+
+func TestHashingEmbedderIsDeterministic(t *testing.T) {
+	text := "A computer hacker learns about the true nature of reality"
+
+	first := embed(t, text)[0]
+	second := embed(t, text)[0]
+	if !slices.Equal(first, second) {
+		t.Error("the same text was embedded into different vectors")
+	}
+
+	// Case, accents and stop words do not change the vector
+	folded := embed(t, "a COMPUTER hacker learns about the true nature of réality")[0]
+	if !slices.Equal(first, folded) {
+		t.Error("case and accents changed the vector")
+	}
+}
+
+func TestHashingEmbedderVectors(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		zero bool
+	}{
+		{name: "sentence", text: "Two imprisoned men bond over a number of years"},
+		{name: "repeated words", text: "heist heist heist crew"},
+		{name: "single word", text: "Alien"},
+		{name: "only stop words", text: "the and of a", zero: true},
+		{name: "empty", text: "", zero: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vector := embed(t, tt.text)[0]
+			if len(vector) != EmbeddingDimensions {
+				t.Fatalf("got %d dimensions, want %d", len(vector), EmbeddingDimensions)
+			}
+
+			var norm float64
+			for _, value := range vector {
+				norm += float64(value) * float64(value)
+			}
+			norm = math.Sqrt(norm)
+			switch {
+			case tt.zero && norm != 0:
+				t.Errorf("got norm %f for a text without words, want 0", norm)
+			case !tt.zero && math.Abs(norm-1) > 1e-5:
+				t.Errorf("got norm %f, want 1", norm)
+			}
+		})
+	}
+}
+
+func TestHashingEmbedderSharedWordsAreSimilar(t *testing.T) {
+	vectors := embed(t,
+		"A space crew is hunted by an alien creature aboard their ship",
+		"The crew of a space ship fights an alien creature",
+		"A chef opens a small restaurant in Paris",
+	)
+
+	related := cosineSimilarity(vectors[0], vectors[1])
+	unrelated := cosineSimilarity(vectors[0], vectors[2])
+	if related <= unrelated {
+		t.Errorf("texts sharing words scored %f, no lower than unrelated texts at %f", related, unrelated)
+	}
+}
+
+func TestEmbeddingKey(t *testing.T) {
+	tests := map[string]string{
+		"hashing-v1":             "hashing_v1",
+		"text-embedding-3-small": "text_embedding_3_small",
+		"Org/Model.v2":           "org_model_v2",
+	}
+
+	for model, want := range tests {
+		if got := embeddingKey(model); got != want {
+			t.Errorf("embeddingKey(%q) = %q, want %q", model, got, want)
+		}
+	}
+}
@@ -8,6 +8,15 @@ const (
 	MovieCreatedEventType = "movies_movie_created"
 	MovieUpdatedEventType = "movies_movie_updated"
 	MovieDeletedEventType = "movies_movie_deleted"
+	// MovieImportCompletedEventType summarizes a bulk import, which publishes
+	// no per-movie events.
+	MovieImportCompletedEventType = "movies_import_completed"
+	MoviesMergedEventType         = "movies_movies_merged"
+	LocalizationsChangedEventType = "movies_localizations_changed"
+
+	CollectionCreatedEventType = "movies_collection_created"
+	CollectionUpdatedEventType = "movies_collection_updated"
+	CollectionDeletedEventType = "movies_collection_deleted"
 )
 
 type MovieCreatedEvent struct {
@@ -17,9 +26,23 @@ type MovieCreatedEvent struct {
 }
 
 func init() {
-	shared.GlobalEventBus.RegisterEventType(MovieCreatedEventType, &MovieCreatedEvent{}, &Handler{})
-	shared.GlobalEventBus.RegisterEventType(MovieUpdatedEventType, &MovieUpdatedEvent{}, &Handler{})
-	shared.GlobalEventBus.RegisterEventType(MovieDeletedEventType, &MovieDeletedEvent{}, &Handler{})
+	RegisterEventHandler(shared.GlobalEventBus, NewHandler(shared.Logger, nil, nil))
+}
+
+// RegisterEventHandler registers the movie events with the handler that
+// processes them. The application registers them again with a handler that
+// maintains the suggestion index and embeddings once it has connected to
+// MongoDB, and with a SearchIndexHandler on an event bus of its own.
+func RegisterEventHandler(eventBus *shared.EventBus, handler *Handler) {
+	eventBus.RegisterEventType(MovieCreatedEventType, &MovieCreatedEvent{}, handler)
+	eventBus.RegisterEventType(MovieUpdatedEventType, &MovieUpdatedEvent{}, handler)
+	eventBus.RegisterEventType(MovieDeletedEventType, &MovieDeletedEvent{}, handler)
+	eventBus.RegisterEventType(MovieImportCompletedEventType, &MovieImportCompletedEvent{}, handler)
+	eventBus.RegisterEventType(MoviesMergedEventType, &MoviesMergedEvent{}, handler)
+	eventBus.RegisterEventType(LocalizationsChangedEventType, &LocalizationsChangedEvent{}, handler)
+	eventBus.RegisterEventType(CollectionCreatedEventType, &CollectionCreatedEvent{}, handler)
+	eventBus.RegisterEventType(CollectionUpdatedEventType, &CollectionUpdatedEvent{}, handler)
+	eventBus.RegisterEventType(CollectionDeletedEventType, &CollectionDeletedEvent{}, handler)
 }
 
 func (e MovieCreatedEvent) GetPayload() interface{} {
@@ -40,27 +63,33 @@ func NewMovieCreatedEvent(movieID string, title string) *MovieCreatedEvent {
 	}
 }
 
+// MovieUpdatedEvent carries the JSON names of the catalog fields that
+// changed, such as title and release_date.
 type MovieUpdatedEvent struct {
 	shared.BaseEvent
-	MovieID string `json:"movie_id"`
-	Title   string `json:"title"`
+	MovieID       string   `json:"movie_id"`
+	Title         string   `json:"title"`
+	ChangedFields []string `json:"changed_fields"`
 }
 
 func (e MovieUpdatedEvent) GetPayload() interface{} {
 	return struct {
-		MovieID string `json:"movie_id"`
-		Title   string `json:"title"`
+		MovieID       string   `json:"movie_id"`
+		Title         string   `json:"title"`
+		ChangedFields []string `json:"changed_fields"`
 	}{
-		MovieID: e.MovieID,
-		Title:   e.Title,
+		MovieID:       e.MovieID,
+		Title:         e.Title,
+		ChangedFields: e.ChangedFields,
 	}
 }
 
-func NewMovieUpdatedEvent(movieID, title string) *MovieUpdatedEvent {
+func NewMovieUpdatedEvent(movieID, title string, changedFields []string) *MovieUpdatedEvent {
 	return &MovieUpdatedEvent{
-		BaseEvent: shared.NewBaseEvent(MovieUpdatedEventType),
-		MovieID:   movieID,
-		Title:     title,
+		BaseEvent:     shared.NewBaseEvent(MovieUpdatedEventType),
+		MovieID:       movieID,
+		Title:         title,
+		ChangedFields: changedFields,
 	}
 }
 
@@ -87,3 +116,177 @@ func NewMovieDeletedEvent(movieID, title string) *MovieDeletedEvent {
 		Title:     title,
 	}
 }
+
+type MovieImportCompletedEvent struct {
+	shared.BaseEvent
+	Format string        `json:"format"`
+	Source string        `json:"source"`
+	Counts ImportSummary `json:"counts"`
+}
+
+func (e MovieImportCompletedEvent) GetPayload() interface{} {
+	return struct {
+		Format string        `json:"format"`
+		Source string        `json:"source"`
+		Counts ImportSummary `json:"counts"`
+	}{
+		Format: e.Format,
+		Source: e.Source,
+		Counts: e.Counts,
+	}
+}
+
+func NewMovieImportCompletedEvent(format ImportFormat, source string, counts ImportSummary) *MovieImportCompletedEvent {
+	return &MovieImportCompletedEvent{
+		BaseEvent: shared.NewBaseEvent(MovieImportCompletedEventType),
+		Format:    string(format),
+		Source:    source,
+		Counts:    counts,
+	}
+}
+
+// MoviesMergedEvent tells that the merged movies are gone and everything
+// referring to them now refers to MovieID.
+type MoviesMergedEvent struct {
+	shared.BaseEvent
+	MovieID   string   `json:"movie_id"`
+	Title     string   `json:"title"`
+	MergedIDs []string `json:"merged_ids"`
+}
+
+func (e MoviesMergedEvent) GetPayload() interface{} {
+	return struct {
+		MovieID   string   `json:"movie_id"`
+		Title     string   `json:"title"`
+		MergedIDs []string `json:"merged_ids"`
+	}{
+		MovieID:   e.MovieID,
+		Title:     e.Title,
+		MergedIDs: e.MergedIDs,
+	}
+}
+
+func NewMoviesMergedEvent(movieID, title string, mergedIDs []string) *MoviesMergedEvent {
+	return &MoviesMergedEvent{
+		BaseEvent: shared.NewBaseEvent(MoviesMergedEventType),
+		MovieID:   movieID,
+		Title:     title,
+		MergedIDs: mergedIDs,
+	}
+}
+
+// LocalizationsChangedEvent carries the locales a movie is now translated to
+// and the countries it has releases in.
+type LocalizationsChangedEvent struct {
+	shared.BaseEvent
+	MovieID   string   `json:"movie_id"`
+	Title     string   `json:"title"`
+	Locales   []string `json:"locales"`
+	Countries []string `json:"countries"`
+}
+
+func (e LocalizationsChangedEvent) GetPayload() interface{} {
+	return struct {
+		MovieID   string   `json:"movie_id"`
+		Title     string   `json:"title"`
+		Locales   []string `json:"locales"`
+		Countries []string `json:"countries"`
+	}{
+		MovieID:   e.MovieID,
+		Title:     e.Title,
+		Locales:   e.Locales,
+		Countries: e.Countries,
+	}
+}
+
+func NewLocalizationsChangedEvent(movieID, title string, locales, countries []string) *LocalizationsChangedEvent {
+	return &LocalizationsChangedEvent{
+		BaseEvent: shared.NewBaseEvent(LocalizationsChangedEventType),
+		MovieID:   movieID,
+		Title:     title,
+		Locales:   locales,
+		Countries: countries,
+	}
+}
+
+// CollectionCreatedEvent and CollectionUpdatedEvent carry the members of the
+// collection in order.
+type CollectionCreatedEvent struct {
+	shared.BaseEvent
+	CollectionID string   `json:"collection_id"`
+	Name         string   `json:"name"`
+	MovieIDs     []string `json:"movie_ids"`
+}
+
+func (e CollectionCreatedEvent) GetPayload() interface{} {
+	return struct {
+		CollectionID string   `json:"collection_id"`
+		Name         string   `json:"name"`
+		MovieIDs     []string `json:"movie_ids"`
+	}{
+		CollectionID: e.CollectionID,
+		Name:         e.Name,
+		MovieIDs:     e.MovieIDs,
+	}
+}
+
+func NewCollectionCreatedEvent(collectionID, name string, movieIDs []string) *CollectionCreatedEvent {
+	return &CollectionCreatedEvent{
+		BaseEvent:    shared.NewBaseEvent(CollectionCreatedEventType),
+		CollectionID: collectionID,
+		Name:         name,
+		MovieIDs:     movieIDs,
+	}
+}
+
+type CollectionUpdatedEvent struct {
+	shared.BaseEvent
+	CollectionID string   `json:"collection_id"`
+	Name         string   `json:"name"`
+	MovieIDs     []string `json:"movie_ids"`
+}
+
+func (e CollectionUpdatedEvent) GetPayload() interface{} {
+	return struct {
+		CollectionID string   `json:"collection_id"`
+		Name         string   `json:"name"`
+		MovieIDs     []string `json:"movie_ids"`
+	}{
+		CollectionID: e.CollectionID,
+		Name:         e.Name,
+		MovieIDs:     e.MovieIDs,
+	}
+}
+
+func NewCollectionUpdatedEvent(collectionID, name string, movieIDs []string) *CollectionUpdatedEvent {
+	return &CollectionUpdatedEvent{
+		BaseEvent:    shared.NewBaseEvent(CollectionUpdatedEventType),
+		CollectionID: collectionID,
+		Name:         name,
+		MovieIDs:     movieIDs,
+	}
+}
+
+type CollectionDeletedEvent struct {
+	shared.BaseEvent
+	CollectionID string `json:"collection_id"`
+	Name         string `json:"name"`
+}
+
+func (e CollectionDeletedEvent) GetPayload() interface{} {
+	return struct {
+		CollectionID string `json:"collection_id"`
+		Name         string `json:"name"`
+	}{
+		CollectionID: e.CollectionID,
+		Name:         e.Name,
+	}
+}
+
+func NewCollectionDeletedEvent(collectionID, name string) *CollectionDeletedEvent {
+	return &CollectionDeletedEvent{
+		BaseEvent:    shared.NewBaseEvent(CollectionDeletedEventType),
+		CollectionID: collectionID,
+		Name:         name,
+	}
+}
@@ -0,0 +1,109 @@
+package movies
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// ExternalSource is a catalog that identifies movies independently of us.
+type ExternalSource string
+
+const (
+	SourceIMDb     ExternalSource = "imdb"
+	SourceTMDb     ExternalSource = "tmdb"
+	SourceWikidata ExternalSource = "wikidata"
+)
+
+var ExternalSources = []ExternalSource{SourceIMDb, SourceTMDb, SourceWikidata}
+
+var (
+	imdbIDPattern     = regexp.MustCompile(`^tt[0-9]{7,}$`)
+	wikidataIDPattern = regexp.MustCompile(`^Q[1-9][0-9]*$`)
+)
+
+// ExternalIDs are the IDs of a movie in other catalogs. The IMDb and TMDb IDs
+// are the imdb_id and external_id fields of the movie; the Wikidata ID is
+// only stored alongside them.
+type ExternalIDs struct {
+	IMDb     string `json:"imdb,omitempty" bson:"imdbid"`
+	TMDb     int    `json:"tmdb,omitempty" bson:"externalid"`
+	Wikidata string `json:"wikidata,omitempty" bson:"wikidataid,omitempty"`
+}
+
+func (ids ExternalIDs) validate() map[string]string {
+	fields := make(map[string]string)
+
+	if ids.IMDb != "" && !imdbIDPattern.MatchString(ids.IMDb) {
+		fields["imdb"] = "must be an IMDb title ID such as tt0133093"
+	}
+	if ids.TMDb < 0 {
+		fields["tmdb"] = "must not be negative"
+	}
+	if ids.Wikidata != "" && !wikidataIDPattern.MatchString(ids.Wikidata) {
+		fields["wikidata"] = "must be a Wikidata item ID such as Q83495"
+	}
+
+	return fields
+}
+
+func (ids ExternalIDs) isEmpty() bool {
+	return ids == ExternalIDs{}
+}
+
+// count is how many of the IDs are set.
+func (ids ExternalIDs) count() int {
+	count := 0
+	for _, set := range []bool{ids.IMDb != "", ids.TMDb != 0, ids.Wikidata != ""} {
+		if set {
+			count++
+		}
+	}
+	return count
+}
+
+// conflicts reports whether both have a different ID in the same catalog,
+// which makes them different movies.
+func (ids ExternalIDs) conflicts(other ExternalIDs) bool {
+	return (ids.IMDb != "" && other.IMDb != "" && ids.IMDb != other.IMDb) ||
+		(ids.TMDb != 0 && other.TMDb != 0 && ids.TMDb != other.TMDb) ||
+		(ids.Wikidata != "" && other.Wikidata != "" && ids.Wikidata != other.Wikidata)
+}
+
+// fillFrom sets the IDs missing from ids to those of other.
+func (ids ExternalIDs) fillFrom(other ExternalIDs) ExternalIDs {
+	if ids.IMDb == "" {
+		ids.IMDb = other.IMDb
+	}
+	if ids.TMDb == 0 {
+		ids.TMDb = other.TMDb
+	}
+	if ids.Wikidata == "" {
+		ids.Wikidata = other.Wikidata
+	}
+	return ids
+}
+
+// parseExternalID checks an ID of the source and returns the document key
+// and value to look it up by.
+func parseExternalID(source ExternalSource, id string) (string, interface{}, error) {
+	switch source {
+	case SourceIMDb:
+		if !imdbIDPattern.MatchString(id) {
+			return "", nil, ErrInvalidExternalID
+		}
+		return fieldIMDbID, id, nil
+	case SourceTMDb:
+		tmdbID, err := strconv.Atoi(id)
+		if err != nil || tmdbID <= 0 {
+			return "", nil, ErrInvalidExternalID
+		}
+		return fieldExternalID, tmdbID, nil
+	case SourceWikidata:
+		if !wikidataIDPattern.MatchString(id) {
+			return "", nil, ErrInvalidExternalID
+		}
+		return fieldWikidataID, id, nil
+	default:
+		return "", nil, ErrInvalidExternalSource
+	}
+}
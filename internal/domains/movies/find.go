@@ -0,0 +1,320 @@
+package movies
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"event-driven-go/internal/shared"
+	schema "github.com/nameteos/my-movies-db-schema/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// MovieSort orders the results of FindMovies.
+type MovieSort string
+
+const (
+	// SortRelevance puts the best text matches first and needs a text filter.
+	SortRelevance MovieSort = "relevance"
+	// SortReleaseDate puts the newest releases first.
+	SortReleaseDate MovieSort = "release_date"
+	SortTitle       MovieSort = "title"
+	// SortRuntime puts the longest movies first.
+	SortRuntime MovieSort = "runtime"
+	// SortAdded puts the movies added to the catalog last first.
+	SortAdded MovieSort = "added"
+)
+
+var MovieSorts = []MovieSort{SortRelevance, SortReleaseDate, SortTitle, SortRuntime, SortAdded}
+
+var (
+	titleOrder   = []sortField{{fieldTitle, false}, {"_id", false}}
+	runtimeOrder = []sortField{{fieldRuntime, true}, {"_id", false}}
+)
+
+func (s MovieSort) order() []sortField {
+	switch s {
+	case SortRelevance:
+		return relevanceOrder
+	case SortTitle:
+		return titleOrder
+	case SortRuntime:
+		return runtimeOrder
+	case SortAdded:
+		return recentOrder
+	default:
+		return releaseOrder
+	}
+}
+
+// MovieQuery combines the filters of FindMovies, leaving out those at their
+// zero value. A movie matches when it has any of the genres and all of the
+// cast. Years and runtimes in minutes are inclusive bounds.
+type MovieQuery struct {
+	Text       string
+	Genres     []string
+	YearFrom   int
+	YearTo     int
+	Director   string
+	Cast       []string
+	RuntimeMin int
+	RuntimeMax int
+	// Language is the ISO 639-1 code of the original language.
+	Language string
+	// Sort defaults to relevance with a text filter and to release date without.
+	Sort MovieSort
+	Page shared.PageRequest
+	// AcceptLanguage localizes the movies found, see Service.GetLocalizedMovie.
+	AcceptLanguage string
+
+	// matches are the search index's matches of Text.
+	matches []TextMatch
+}
+
+func (q *MovieQuery) validate() map[string]string {
+	fields := make(map[string]string)
+
+	for key, year := range map[string]int{"year_from": q.YearFrom, "year_to": q.YearTo} {
+		if year != 0 && (year < 1800 || year > 9999) {
+			fields[key] = "must be a four digit year"
+		}
+	}
+	if q.YearFrom != 0 && q.YearTo != 0 && q.YearTo < q.YearFrom {
+		fields["year_to"] = "must not be before year_from"
+	}
+	if q.RuntimeMin < 0 {
+		fields["runtime_min"] = "must not be negative"
+	}
+	if q.RuntimeMax < 0 {
+		fields["runtime_max"] = "must not be negative"
+	}
+	if q.RuntimeMax > 0 && q.RuntimeMax < q.RuntimeMin {
+		fields["runtime_max"] = "must not be less than runtime_min"
+	}
+	switch {
+	case q.Sort != "" && !slices.Contains(MovieSorts, q.Sort):
+		fields["sort"] = "must be one of relevance, release_date, title, runtime or added"
+	case q.Sort == SortRelevance && q.Text == "":
+		fields["sort"] = "can only be relevance with a text filter"
+	}
+
+	return fields
+}
+
+// filter is the match stage of the query.
+func (q *MovieQuery) filter() bson.M {
+	filter := bson.M{}
+	if q.Text != "" {
+		ids, _ := splitMatches(q.matches)
+		filter["_id"] = bson.M{"$in": ids}
+	}
+	if len(q.Genres) > 0 {
+		filter[fieldGenreName] = bson.M{"$in": q.Genres}
+	}
+	if q.YearFrom != 0 || q.YearTo != 0 {
+		// Release dates are stored as YYYY-MM-DD strings, which order like
+		// dates and after any shorter prefix of themselves
+		releaseDate := bson.M{"$gte": fmt.Sprintf("%04d", q.YearFrom)}
+		if q.YearTo != 0 {
+			releaseDate["$lt"] = fmt.Sprintf("%04d", q.YearTo+1)
+		}
+		filter[fieldReleaseDate] = releaseDate
+	}
+	if q.Director != "" {
+		filter[fieldDirector] = q.Director
+	}
+	if len(q.Cast) > 0 {
+		filter[fieldCast] = bson.M{"$all": q.Cast}
+	}
+	if q.RuntimeMin != 0 || q.RuntimeMax != 0 {
+		runtime := bson.M{"$gte": q.RuntimeMin}
+		if q.RuntimeMax != 0 {
+			runtime["$lte"] = q.RuntimeMax
+		}
+		filter[fieldRuntime] = runtime
+	}
+	if q.Language != "" {
+		filter[fieldLanguage] = q.Language
+	}
+	return filter
+}
+
+// FacetCount is how many matching movies have a value of a facet.
+type FacetCount struct {
+	Value string `json:"value" bson:"_id"`
+	Count int    `json:"count" bson:"count"`
+}
+
+// MovieFacets break down all movies matching a query, not only the page of
+// them returned. Genres and languages are counted most common first, and
+// decades such as "1990s" in order.
+type MovieFacets struct {
+	Genres    []FacetCount `json:"genres"`
+	Decades   []FacetCount `json:"decades"`
+	Languages []FacetCount `json:"languages"`
+}
+
+// MovieSearchResult is a page of the movies matching a query, how many
+// match in total and their facets.
+type MovieSearchResult struct {
+	Page   *shared.Page[*schema.Movie]
+	Total  int
+	Facets MovieFacets
+}
+
+// movieFacetResult is the single document of the facet stage.
+type movieFacetResult struct {
+	Results   []*scoredMovie        `bson:"results"`
+	Total     []struct{ Count int } `bson:"total"`
+	Genres    []FacetCount          `bson:"genres"`
+	Decades   []FacetCount          `bson:"decades"`
+	Languages []FacetCount          `bson:"languages"`
+}
+
+// FindMovies pages through the movies matching all filters of the query,
+// counting the facets of the matches in the same aggregation.
+func (r *MongoRepository) FindMovies(ctx context.Context, query MovieQuery) (*MovieSearchResult, error) {
+	var key movieCursor
+	direction, err := query.Page.Seek(&key)
+	if err != nil {
+		return nil, err
+	}
+	if direction != shared.FromStart && key.Sort != query.Sort {
+		return nil, shared.NewFieldError("cursor", "is from a list with another sort")
+	}
+
+	order := query.Sort.order()
+	results := mongo.Pipeline{
+		{{Key: "$match", Value: keysetFilter(order, direction, key.values(order)...)}},
+		{{Key: "$sort", Value: keysetSort(order, direction)}},
+	}
+	if direction == shared.FromStart {
+		results = append(results, bson.D{{Key: "$skip", Value: int64(query.Page.Offset)}})
+	}
+	results = append(results, bson.D{{Key: "$limit", Value: int64(query.Page.Limit + 1)}})
+
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: query.filter()}}}
+	if query.Text != "" {
+		pipeline = append(pipeline, bson.D{{Key: "$addFields", Value: matchScore(splitMatches(query.matches))}})
+	}
+	pipeline = append(pipeline, bson.D{{Key: "$project", Value: movieProjection}})
+	pipeline = append(pipeline, bson.D{{Key: "$facet", Value: bson.M{
+		"results": results,
+		"total":   bson.A{bson.M{"$count": "count"}},
+		"genres": bson.A{
+			bson.M{"$unwind": "$genres"},
+			bson.M{"$sortByCount": "$" + fieldGenreName},
+		},
+		"decades": bson.A{
+			bson.M{"$match": bson.M{fieldReleaseDate: bson.M{"$regex": "^[0-9]{4}"}}},
+			bson.M{"$group": bson.M{
+				"_id":   bson.M{"$concat": bson.A{bson.M{"$substrCP": bson.A{"$" + fieldReleaseDate, 0, 3}}, "0s"}},
+				"count": bson.M{"$sum": 1},
+			}},
+			bson.M{"$sort": bson.M{"_id": 1}},
+		},
+		"languages": bson.A{
+			bson.M{"$match": bson.M{fieldLanguage: bson.M{"$gt": ""}}},
+			bson.M{"$sortByCount": "$" + fieldLanguage},
+		},
+	}}})
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find movies: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var facets movieFacetResult
+	if cursor.Next(ctx) {
+		if err := cursor.Decode(&facets); err != nil {
+			return nil, fmt.Errorf("failed to decode movies: %w", err)
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("failed to find movies: %w", err)
+	}
+
+	scored := shared.NewPage(facets.Results, query.Page, direction, func(result *scoredMovie) interface{} {
+		return result.cursor(query.Sort)
+	})
+	movies := make([]*schema.Movie, 0, len(scored.Items))
+	for _, result := range scored.Items {
+		movies = append(movies, &result.Movie)
+	}
+
+	result := &MovieSearchResult{
+		Page: &shared.Page[*schema.Movie]{Items: movies, NextCursor: scored.NextCursor, PrevCursor: scored.PrevCursor},
+		Facets: MovieFacets{
+			Genres:    nonNil(facets.Genres),
+			Decades:   nonNil(facets.Decades),
+			Languages: nonNil(facets.Languages),
+		},
+	}
+	if len(facets.Total) > 0 {
+		result.Total = facets.Total[0].Count
+	}
+	return result, nil
+}
+
+// cursor is the sort key of a result in the order of the sort.
+func (m *scoredMovie) cursor(sort MovieSort) movieCursor {
+	key := movieCursor{Sort: sort, ID: m.ID}
+	for _, field := range sort.order() {
+		switch field.name {
+		case fieldCreatedAt:
+			key.CreatedAt = m.CreatedAt
+		case fieldReleaseDate:
+			key.ReleaseDate = m.ReleaseDate
+		case fieldTitle:
+			key.Title = m.Title
+		case fieldRuntime:
+			key.Runtime = m.Runtime
+		case fieldScore:
+			key.Score = m.Score
+		}
+	}
+	return key
+}
+
+// values returns the fields of the key in the order's field order.
+func (c movieCursor) values(order []sortField) []interface{} {
+	values := make([]interface{}, 0, len(order))
+	for _, field := range order {
+		switch field.name {
+		case fieldCreatedAt:
+			values = append(values, c.CreatedAt)
+		case fieldReleaseDate:
+			values = append(values, c.ReleaseDate)
+		case fieldTitle:
+			values = append(values, c.Title)
+		case fieldRuntime:
+			values = append(values, c.Runtime)
+		case fieldScore:
+			values = append(values, c.Score)
+		default:
+			values = append(values, c.ID)
+		}
+	}
+	return values
+}
+
+func nonNil(counts []FacetCount) []FacetCount {
+	if counts == nil {
+		return []FacetCount{}
+	}
+	return counts
+}
+
+// normalizeList trims the values and drops the empty ones.
+func normalizeList(values []string) []string {
+	var normalized []string
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			normalized = append(normalized, value)
+		}
+	}
+	return normalized
+}
@@ -0,0 +1,577 @@
+package movies
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	moviesdbv1 "event-driven-go/internal/gen/moviesdb/v1"
+	"event-driven-go/internal/shared"
+	schema "github.com/nameteos/my-movies-db-schema/mongodb"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GRPCServer implements moviesdb.v1.MovieService on top of the service.
+type GRPCServer struct {
+	moviesdbv1.UnimplementedMovieServiceServer
+	service *Service
+	logger  *slog.Logger
+}
+
+func NewGRPCServer(service *Service, logger *slog.Logger) *GRPCServer {
+	return &GRPCServer{
+		service: service,
+		logger:  logger.With(slog.String("domain", "movies")),
+	}
+}
+
+func (s *GRPCServer) Register(server *grpc.Server) {
+	moviesdbv1.RegisterMovieServiceServer(server, s)
+}
+
+func (s *GRPCServer) CreateMovie(ctx context.Context, req *moviesdbv1.CreateMovieRequest) (*moviesdbv1.Movie, error) {
+	request := fromProtoMovieFields(req.GetFields())
+	if fields := request.validate(); len(fields) > 0 {
+		return nil, shared.NewValidationError("invalid movie", fields)
+	}
+
+	movie := &schema.Movie{}
+	request.applyTo(movie)
+
+	createdMovie, err := s.service.CreateMovie(ctx, movie)
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoMovie(createdMovie), nil
+}
+
+func (s *GRPCServer) GetMovie(ctx context.Context, req *moviesdbv1.GetMovieRequest) (*moviesdbv1.Movie, error) {
+	movie, err := s.service.GetLocalizedMovie(ctx, req.GetId(), req.GetAcceptLanguage())
+	if err != nil {
+		return nil, err
+	}
+
+	response := toProtoMovie(movie.Movie)
+	response.Locale = movie.Locale
+	if movie.Release != nil {
+		response.Release = toProtoRelease(*movie.Release)
+	}
+	return response, nil
+}
+
+func (s *GRPCServer) UpdateMovie(ctx context.Context, req *moviesdbv1.UpdateMovieRequest) (*moviesdbv1.Movie, error) {
+	request := fromProtoMovieFields(req.GetFields())
+	if fields := request.validate(); len(fields) > 0 {
+		return nil, shared.NewValidationError("invalid movie", fields)
+	}
+
+	movie, err := s.service.GetMovieByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	request.applyTo(movie)
+
+	updatedMovie, err := s.service.UpdateMovie(ctx, movie)
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoMovie(updatedMovie), nil
+}
+
+func (s *GRPCServer) DeleteMovie(ctx context.Context, req *moviesdbv1.DeleteMovieRequest) (*emptypb.Empty, error) {
+	if err := s.service.DeleteMovie(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+// ListMovies serves the recent movies, or the result of the filter when one is set.
+func (s *GRPCServer) ListMovies(ctx context.Context, req *moviesdbv1.ListMoviesRequest) (*moviesdbv1.ListMoviesResponse, error) {
+	page, err := shared.ValidatePageRequest(req.GetLimit(), req.GetOffset(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	var movies *shared.Page[*schema.Movie]
+	switch filter := req.GetFilter().(type) {
+	case *moviesdbv1.ListMoviesRequest_Query:
+		movies, err = s.service.SearchMovies(ctx, filter.Query, page)
+	case *moviesdbv1.ListMoviesRequest_Genre:
+		movies, err = s.service.GetMoviesByGenre(ctx, filter.Genre, page)
+	case *moviesdbv1.ListMoviesRequest_Year:
+		if filter.Year < 1800 || filter.Year > 9999 {
+			return nil, shared.NewFieldError("year", "must be a four digit year")
+		}
+		movies, err = s.service.GetMoviesByYear(ctx, int(filter.Year), page)
+	case *moviesdbv1.ListMoviesRequest_Director:
+		movies, err = s.service.GetMoviesByDirector(ctx, filter.Director, page)
+	default:
+		movies, err = s.service.GetRecentMovies(ctx, page)
+	}
+	if err == nil {
+		err = s.service.LocalizeMovies(ctx, movies.Items, req.GetAcceptLanguage())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	response := &moviesdbv1.ListMoviesResponse{
+		HasMore:    movies.NextCursor != "",
+		NextCursor: movies.NextCursor,
+		PrevCursor: movies.PrevCursor,
+	}
+	for _, movie := range movies.Items {
+		response.Movies = append(response.Movies, toProtoMovie(movie))
+	}
+
+	return response, nil
+}
+
+func (s *GRPCServer) FindMovies(ctx context.Context, req *moviesdbv1.FindMoviesRequest) (*moviesdbv1.FindMoviesResponse, error) {
+	page, err := shared.ValidatePageRequest(req.GetLimit(), req.GetOffset(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.service.FindMovies(ctx, MovieQuery{
+		Text:       req.GetText(),
+		Genres:     req.GetGenres(),
+		YearFrom:   int(req.GetYearFrom()),
+		YearTo:     int(req.GetYearTo()),
+		Director:   req.GetDirector(),
+		Cast:       req.GetCast(),
+		RuntimeMin: int(req.GetRuntimeMin()),
+		RuntimeMax: int(req.GetRuntimeMax()),
+		Language:   req.GetLanguage(),
+		Sort:       MovieSort(req.GetSort()),
+		Page:       page,
+
+		AcceptLanguage: req.GetAcceptLanguage(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := &moviesdbv1.FindMoviesResponse{
+		HasMore:    result.Page.NextCursor != "",
+		NextCursor: result.Page.NextCursor,
+		PrevCursor: result.Page.PrevCursor,
+		Total:      int32(result.Total),
+		Genres:     toProtoFacetCounts(result.Facets.Genres),
+		Decades:    toProtoFacetCounts(result.Facets.Decades),
+		Languages:  toProtoFacetCounts(result.Facets.Languages),
+	}
+	for _, movie := range result.Page.Items {
+		response.Movies = append(response.Movies, toProtoMovie(movie))
+	}
+
+	return response, nil
+}
+
+func toProtoFacetCounts(counts []FacetCount) []*moviesdbv1.FacetCount {
+	protoCounts := make([]*moviesdbv1.FacetCount, 0, len(counts))
+	for _, count := range counts {
+		protoCounts = append(protoCounts, &moviesdbv1.FacetCount{Value: count.Value, Count: int32(count.Count)})
+	}
+	return protoCounts
+}
+
+func (s *GRPCServer) GetMovieByExternalId(ctx context.Context, req *moviesdbv1.GetMovieByExternalIdRequest) (*moviesdbv1.Movie, error) {
+	movie, err := s.service.GetMovieByExternalID(ctx, ExternalSource(req.GetSource()), req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoMovie(movie), nil
+}
+
+func (s *GRPCServer) GetExternalIds(ctx context.Context, req *moviesdbv1.GetExternalIdsRequest) (*moviesdbv1.ExternalIds, error) {
+	ids, err := s.service.GetExternalIDs(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoExternalIDs(ids), nil
+}
+
+func (s *GRPCServer) SetExternalIds(ctx context.Context, req *moviesdbv1.SetExternalIdsRequest) (*moviesdbv1.ExternalIds, error) {
+	ids, err := s.service.SetExternalIDs(ctx, req.GetId(), ExternalIDs{
+		IMDb:     req.GetExternalIds().GetImdb(),
+		TMDb:     int(req.GetExternalIds().GetTmdb()),
+		Wikidata: req.GetExternalIds().GetWikidata(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoExternalIDs(ids), nil
+}
+
+func (s *GRPCServer) ListMovieRevisions(ctx context.Context, req *moviesdbv1.ListMovieRevisionsRequest) (*moviesdbv1.ListMovieRevisionsResponse, error) {
+	page, err := shared.ValidatePageRequest(req.GetLimit(), req.GetOffset(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := s.service.ListRevisions(ctx, req.GetMovieId(), page)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &moviesdbv1.ListMovieRevisionsResponse{
+		Revisions:  make([]*moviesdbv1.Revision, 0, len(revisions.Items)),
+		HasMore:    revisions.NextCursor != "",
+		NextCursor: revisions.NextCursor,
+		PrevCursor: revisions.PrevCursor,
+	}
+	for _, revision := range revisions.Items {
+		revisionProto, err := toProtoRevision(revision)
+		if err != nil {
+			return nil, err
+		}
+		response.Revisions = append(response.Revisions, revisionProto)
+	}
+
+	return response, nil
+}
+
+func (s *GRPCServer) RevertMovie(ctx context.Context, req *moviesdbv1.RevertMovieRequest) (*moviesdbv1.Movie, error) {
+	movie, err := s.service.RevertMovie(ctx, req.GetId(), req.GetRevisionId())
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoMovie(movie), nil
+}
+
+func (s *GRPCServer) GetLocalizations(ctx context.Context, req *moviesdbv1.GetLocalizationsRequest) (*moviesdbv1.Localizations, error) {
+	localizations, err := s.service.GetLocalizations(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoLocalizations(localizations), nil
+}
+
+func (s *GRPCServer) SetLocalizations(ctx context.Context, req *moviesdbv1.SetLocalizationsRequest) (*moviesdbv1.Localizations, error) {
+	request := Localizations{Translations: make(map[string]Translation)}
+	for locale, translation := range req.GetLocalizations().GetTranslations() {
+		request.Translations[locale] = Translation{Title: translation.GetTitle(), Overview: translation.GetOverview()}
+	}
+	for _, release := range req.GetLocalizations().GetReleases() {
+		request.Releases = append(request.Releases, Release{
+			Country:       release.GetCountry(),
+			Date:          release.GetDate(),
+			Certification: release.GetCertification(),
+		})
+	}
+
+	localizations, err := s.service.SetLocalizations(ctx, req.GetId(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoLocalizations(localizations), nil
+}
+
+func (s *GRPCServer) MergeMovies(ctx context.Context, req *moviesdbv1.MergeMoviesRequest) (*moviesdbv1.Movie, error) {
+	movie, err := s.service.MergeMovies(ctx, req.GetId(), req.GetDuplicateIds())
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoMovie(movie), nil
+}
+
+func (s *GRPCServer) Autocomplete(ctx context.Context, req *moviesdbv1.AutocompleteRequest) (*moviesdbv1.AutocompleteResponse, error) {
+	suggestions, err := s.service.Autocomplete(ctx, req.GetPrefix(), int(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &moviesdbv1.AutocompleteResponse{
+		Suggestions: make([]*moviesdbv1.Suggestion, 0, len(suggestions)),
+	}
+	for _, suggestion := range suggestions {
+		response.Suggestions = append(response.Suggestions, &moviesdbv1.Suggestion{
+			MovieId:    suggestion.MovieID,
+			Title:      suggestion.Title,
+			Year:       int32(suggestion.Year),
+			Popularity: int32(suggestion.Popularity),
+		})
+	}
+
+	return response, nil
+}
+
+func (s *GRPCServer) SimilarMovies(ctx context.Context, req *moviesdbv1.SimilarMoviesRequest) (*moviesdbv1.MovieMatchesResponse, error) {
+	matches, err := s.service.SimilarMovies(ctx, req.GetId(), int(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoMovieMatches(matches), nil
+}
+
+func (s *GRPCServer) SemanticSearch(ctx context.Context, req *moviesdbv1.SemanticSearchRequest) (*moviesdbv1.MovieMatchesResponse, error) {
+	matches, err := s.service.SemanticSearch(ctx, req.GetQuery(), int(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoMovieMatches(matches), nil
+}
+
+func (s *GRPCServer) CreateCollection(ctx context.Context, req *moviesdbv1.CreateCollectionRequest) (*moviesdbv1.Collection, error) {
+	collection, err := s.service.CreateCollection(ctx, fromProtoCollectionFields(req.GetFields()))
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoCollection(collection), nil
+}
+
+func (s *GRPCServer) GetCollection(ctx context.Context, req *moviesdbv1.GetCollectionRequest) (*moviesdbv1.Collection, error) {
+	collection, err := s.service.GetCollection(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoCollection(collection), nil
+}
+
+func (s *GRPCServer) ListCollections(ctx context.Context, req *moviesdbv1.ListCollectionsRequest) (*moviesdbv1.ListCollectionsResponse, error) {
+	page, err := shared.ValidatePageRequest(req.GetLimit(), req.GetOffset(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	collections, err := s.service.ListCollections(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &moviesdbv1.ListCollectionsResponse{
+		Collections: make([]*moviesdbv1.Collection, 0, len(collections.Items)),
+		HasMore:     collections.NextCursor != "",
+		NextCursor:  collections.NextCursor,
+		PrevCursor:  collections.PrevCursor,
+	}
+	for _, collection := range collections.Items {
+		response.Collections = append(response.Collections, toProtoCollection(collection))
+	}
+
+	return response, nil
+}
+
+func (s *GRPCServer) UpdateCollection(ctx context.Context, req *moviesdbv1.UpdateCollectionRequest) (*moviesdbv1.Collection, error) {
+	collection, err := s.service.UpdateCollection(ctx, req.GetId(), fromProtoCollectionFields(req.GetFields()))
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoCollection(collection), nil
+}
+
+func (s *GRPCServer) DeleteCollection(ctx context.Context, req *moviesdbv1.DeleteCollectionRequest) (*emptypb.Empty, error) {
+	if err := s.service.DeleteCollection(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *GRPCServer) ListMovieCollections(ctx context.Context, req *moviesdbv1.ListMovieCollectionsRequest) (*moviesdbv1.ListMovieCollectionsResponse, error) {
+	collections, err := s.service.GetMovieCollections(ctx, req.GetMovieId())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &moviesdbv1.ListMovieCollectionsResponse{Collections: make([]*moviesdbv1.Collection, 0, len(collections))}
+	for _, collection := range collections {
+		response.Collections = append(response.Collections, toProtoCollection(collection))
+	}
+
+	return response, nil
+}
+
+func fromProtoCollectionFields(fields *moviesdbv1.CollectionFields) CollectionInput {
+	return CollectionInput{
+		Name:        fields.GetName(),
+		Description: fields.GetDescription(),
+		MovieIDs:    fields.GetMovieIds(),
+	}
+}
+
+func toProtoCollection(collection *Collection) *moviesdbv1.Collection {
+	response := &moviesdbv1.Collection{
+		Id: collection.ID.Hex(),
+		Fields: &moviesdbv1.CollectionFields{
+			Name:        collection.Name,
+			Description: collection.Description,
+			MovieIds:    hexIDs(collection.MovieIDs),
+		},
+		CreatedAt: timestamppb.New(collection.CreatedAt),
+		UpdatedAt: timestamppb.New(collection.UpdatedAt),
+	}
+	for _, movie := range collection.Movies {
+		response.Movies = append(response.Movies, toProtoMovie(movie))
+	}
+	return response
+}
+
+func toProtoMovieMatches(matches []*MovieMatch) *moviesdbv1.MovieMatchesResponse {
+	response := &moviesdbv1.MovieMatchesResponse{
+		Matches: make([]*moviesdbv1.MovieMatch, 0, len(matches)),
+	}
+	for _, match := range matches {
+		response.Matches = append(response.Matches, &moviesdbv1.MovieMatch{
+			Movie: toProtoMovie(match.Movie),
+			Score: match.Score,
+		})
+	}
+	return response
+}
+
+func toProtoExternalIDs(ids *ExternalIDs) *moviesdbv1.ExternalIds {
+	return &moviesdbv1.ExternalIds{
+		Imdb:     ids.IMDb,
+		Tmdb:     int32(ids.TMDb),
+		Wikidata: ids.Wikidata,
+	}
+}
+
+func toProtoRevision(revision *Revision) (*moviesdbv1.Revision, error) {
+	response := &moviesdbv1.Revision{
+		Id:        revision.ID.Hex(),
+		MovieId:   revision.MovieID.Hex(),
+		Editor:    revision.Editor,
+		Changes:   make([]*moviesdbv1.FieldChange, 0, len(revision.Changes)),
+		CreatedAt: timestamppb.New(revision.CreatedAt),
+	}
+	if revision.RevertedTo != nil {
+		response.RevertedTo = revision.RevertedTo.Hex()
+	}
+	for _, change := range revision.Changes {
+		before, err := toProtoValue(change.Before)
+		if err != nil {
+			return nil, err
+		}
+		after, err := toProtoValue(change.After)
+		if err != nil {
+			return nil, err
+		}
+		response.Changes = append(response.Changes, &moviesdbv1.FieldChange{Field: change.Field, Before: before, After: after})
+	}
+	return response, nil
+}
+
+// toProtoValue converts a value the way it is written in JSON, which also
+// covers the maps and arrays of the BSON decoder.
+func toProtoValue(value interface{}) (*structpb.Value, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode value: %w", err)
+	}
+	result := &structpb.Value{}
+	if err := result.UnmarshalJSON(data); err != nil {
+		return nil, fmt.Errorf("failed to convert value: %w", err)
+	}
+	return result, nil
+}
+
+func toProtoLocalizations(localizations *Localizations) *moviesdbv1.Localizations {
+	response := &moviesdbv1.Localizations{
+		Translations: make(map[string]*moviesdbv1.Translation, len(localizations.Translations)),
+		Releases:     make([]*moviesdbv1.Release, 0, len(localizations.Releases)),
+	}
+	for locale, translation := range localizations.Translations {
+		response.Translations[locale] = &moviesdbv1.Translation{Title: translation.Title, Overview: translation.Overview}
+	}
+	for _, release := range localizations.Releases {
+		response.Releases = append(response.Releases, toProtoRelease(release))
+	}
+	return response
+}
+
+func toProtoRelease(release Release) *moviesdbv1.Release {
+	return &moviesdbv1.Release{
+		Country:       release.Country,
+		Date:          release.Date,
+		Certification: release.Certification,
+	}
+}
+
+func fromProtoMovieFields(fields *moviesdbv1.MovieFields) *MovieRequest {
+	request := &MovieRequest{
+		Title:            fields.GetTitle(),
+		OriginalTitle:    fields.GetOriginalTitle(),
+		OriginalLanguage: fields.GetOriginalLanguage(),
+		Overview:         fields.GetOverview(),
+		Tagline:          fields.GetTagline(),
+		Status:           fields.GetStatus(),
+		ReleaseDate:      fields.GetReleaseDate(),
+		Runtime:          int(fields.GetRuntime()),
+		Adult:            fields.GetAdult(),
+		Budget:           fields.GetBudget(),
+		Revenue:          fields.GetRevenue(),
+		PosterPath:       fields.GetPosterPath(),
+		IMDbID:           fields.GetImdbId(),
+		ExternalID:       int(fields.GetExternalId()),
+	}
+	for _, genre := range fields.GetGenres() {
+		request.Genres = append(request.Genres, schema.TMDBGenre{ID: int(genre.GetId()), Name: genre.GetName()})
+	}
+	for _, language := range fields.GetSpokenLanguages() {
+		request.SpokenLanguages = append(request.SpokenLanguages, schema.TMDBLanguage{
+			ISO6391:     language.GetIso_639_1(),
+			Name:        language.GetName(),
+			EnglishName: language.GetEnglishName(),
+		})
+	}
+
+	return request
+}
+
+func toProtoMovie(movie *schema.Movie) *moviesdbv1.Movie {
+	fields := &moviesdbv1.MovieFields{
+		Title:            movie.Title,
+		OriginalTitle:    movie.OriginalTitle,
+		OriginalLanguage: movie.OriginalLanguage,
+		Overview:         movie.Overview,
+		Tagline:          movie.Tagline,
+		Status:           movie.Status,
+		ReleaseDate:      movie.ReleaseDate,
+		Runtime:          int32(movie.Runtime),
+		Adult:            movie.Adult,
+		Budget:           movie.Budget,
+		Revenue:          movie.Revenue,
+		PosterPath:       movie.PosterPath,
+		ImdbId:           movie.IMDbID,
+		ExternalId:       int32(movie.ExternalID),
+	}
+	for _, genre := range movie.Genres {
+		fields.Genres = append(fields.Genres, &moviesdbv1.Genre{Id: int32(genre.ID), Name: genre.Name})
+	}
+	for _, language := range movie.SpokenLanguages {
+		fields.SpokenLanguages = append(fields.SpokenLanguages, &moviesdbv1.SpokenLanguage{
+			Iso_639_1:   language.ISO6391,
+			Name:        language.Name,
+			EnglishName: language.EnglishName,
+		})
+	}
+
+	return &moviesdbv1.Movie{
+		Id:        movie.ID.Hex(),
+		Fields:    fields,
+		CreatedAt: timestamppb.New(movie.CreatedAt),
+		UpdatedAt: timestamppb.New(movie.UpdatedAt),
+	}
+}
@@ -2,13 +2,30 @@ package movies
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"log"
+	"log/slog"
 
 	"event-driven-go/internal/shared"
 )
 
-type Handler struct{}
+// Handler keeps the suggestion index, the embeddings, the collection
+// members and the revisions in step with the catalog. The search index is
+// kept by a SearchIndexHandler on every instance. Without a repository it
+// only logs the events.
+type Handler struct {
+	repository Repository
+	embedder   Embedder
+	logger     *slog.Logger
+}
+
+func NewHandler(logger *slog.Logger, repository Repository, embedder Embedder) *Handler {
+	return &Handler{
+		repository: repository,
+		embedder:   embedder,
+		logger:     logger.With(slog.String("domain", "movies")),
+	}
+}
 
 func (h *Handler) Handle(ctx context.Context, event shared.Event) error {
 	switch e := event.(type) {
@@ -18,6 +35,18 @@ func (h *Handler) Handle(ctx context.Context, event shared.Event) error {
 		return h.handleMovieUpdated(ctx, e)
 	case *MovieDeletedEvent:
 		return h.handleMovieDeleted(ctx, e)
+	case *MovieImportCompletedEvent:
+		return h.handleMovieImportCompleted(ctx, e)
+	case *MoviesMergedEvent:
+		return h.handleMoviesMerged(ctx, e)
+	case *LocalizationsChangedEvent:
+		return h.handleLocalizationsChanged(ctx, e)
+	case *CollectionCreatedEvent:
+		return h.handleCollectionChanged(ctx, "collection created", e.CollectionID, e.Name)
+	case *CollectionUpdatedEvent:
+		return h.handleCollectionChanged(ctx, "collection updated", e.CollectionID, e.Name)
+	case *CollectionDeletedEvent:
+		return h.handleCollectionChanged(ctx, "collection deleted", e.CollectionID, e.Name)
 	default:
 		return fmt.Errorf("unsupported event type: %T", event)
 	}
@@ -26,27 +55,171 @@ func (h *Handler) Handle(ctx context.Context, event shared.Event) error {
 func (h *Handler) CanHandle(eventType string) bool {
 	return eventType == MovieCreatedEventType ||
 		eventType == MovieUpdatedEventType ||
-		eventType == MovieDeletedEventType
+		eventType == MovieDeletedEventType ||
+		eventType == MovieImportCompletedEventType ||
+		eventType == MoviesMergedEventType ||
+		eventType == LocalizationsChangedEventType ||
+		eventType == CollectionCreatedEventType ||
+		eventType == CollectionUpdatedEventType ||
+		eventType == CollectionDeletedEventType
 }
 
 func (h *Handler) handleMovieCreated(ctx context.Context, event *MovieCreatedEvent) error {
-	log.Printf("🎬 MOVIES: New movie '%s' (%d) added to catalog",
-		event.Title, event.ID)
+	h.logger.InfoContext(ctx, "new movie added to catalog",
+		slog.String("movie_id", event.MovieID),
+		slog.String("title", event.Title),
+	)
 
-	// todo index movie
-
-	return nil
+	return h.refreshMovie(ctx, event.MovieID)
 }
 
 // handleMovieUpdated processes MovieUpdatedEvent
 func (h *Handler) handleMovieUpdated(ctx context.Context, event *MovieUpdatedEvent) error {
-	log.Printf("🎬 MOVIES: Movie '%s' has been updated", event.Title)
+	h.logger.InfoContext(ctx, "movie has been updated",
+		slog.String("movie_id", event.MovieID),
+		slog.String("title", event.Title),
+		slog.Any("changed_fields", event.ChangedFields),
+	)
 
-	return nil
+	return h.refreshMovie(ctx, event.MovieID)
 }
 
 // handleMovieDeleted processes MovieDeletedEvent
 func (h *Handler) handleMovieDeleted(ctx context.Context, event *MovieDeletedEvent) error {
-	log.Printf("🎬 MOVIES: Movie '%s' has been deleted from catalog", event.Title)
+	h.logger.InfoContext(ctx, "movie has been deleted from catalog",
+		slog.String("movie_id", event.MovieID),
+		slog.String("title", event.Title),
+	)
+
+	if h.repository != nil {
+		if err := h.repository.RemoveFromCollections(ctx, event.MovieID); err != nil {
+			return err
+		}
+		if err := h.repository.DeleteRevisions(ctx, event.MovieID); err != nil {
+			return err
+		}
+	}
+	return h.refreshMovie(ctx, event.MovieID)
+}
+
+// handleMovieImportCompleted processes MovieImportCompletedEvent
+func (h *Handler) handleMovieImportCompleted(ctx context.Context, event *MovieImportCompletedEvent) error {
+	h.logger.InfoContext(ctx, "movies imported into catalog",
+		slog.String("format", event.Format),
+		slog.String("source", event.Source),
+		slog.Int("inserted", event.Counts.Inserted),
+		slog.Int("updated", event.Counts.Updated),
+		slog.Int("rejected", event.Counts.Rejected),
+	)
+	if h.repository == nil || event.Counts.Inserted+event.Counts.Updated == 0 {
+		return nil
+	}
+
+	// An import publishes no per-movie events
+	indexed, err := h.repository.RebuildSuggestions(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to rebuild suggestions: %w", err)
+	}
+	h.logger.InfoContext(ctx, "suggestions rebuilt", slog.Int("movies", indexed))
+
+	if h.embedder == nil {
+		return nil
+	}
+	embedded, err := EmbedMissingMovies(ctx, h.repository, h.embedder)
+	if err != nil {
+		return fmt.Errorf("failed to embed imported movies: %w", err)
+	}
+	h.logger.InfoContext(ctx, "imported movies embedded", slog.Int("movies", embedded))
+	return nil
+}
+
+// handleMoviesMerged processes MoviesMergedEvent
+func (h *Handler) handleMoviesMerged(ctx context.Context, event *MoviesMergedEvent) error {
+	h.logger.InfoContext(ctx, "duplicate movies merged",
+		slog.String("movie_id", event.MovieID),
+		slog.String("title", event.Title),
+		slog.Any("merged_ids", event.MergedIDs),
+	)
+	if h.repository == nil {
+		return nil
+	}
+
+	if err := h.repository.MergeSuggestions(ctx, event.MovieID, event.MergedIDs); err != nil {
+		return fmt.Errorf("failed to merge suggestions: %w", err)
+	}
+	if err := h.repository.MergeCollectionMembers(ctx, event.MovieID, event.MergedIDs); err != nil {
+		return err
+	}
+	for _, id := range event.MergedIDs {
+		if err := h.repository.DeleteRevisions(ctx, id); err != nil {
+			return err
+		}
+	}
+	return h.refreshMovie(ctx, event.MovieID)
+}
+
+// handleLocalizationsChanged processes LocalizationsChangedEvent. Only the
+// search index holds translated titles.
+func (h *Handler) handleLocalizationsChanged(ctx context.Context, event *LocalizationsChangedEvent) error {
+	h.logger.InfoContext(ctx, "movie localizations changed",
+		slog.String("movie_id", event.MovieID),
+		slog.String("title", event.Title),
+		slog.Any("locales", event.Locales),
+		slog.Any("countries", event.Countries),
+	)
+	return nil
+}
+
+func (h *Handler) handleCollectionChanged(ctx context.Context, message, collectionID, name string) error {
+	h.logger.InfoContext(ctx, message,
+		slog.String("collection_id", collectionID),
+		slog.String("name", name),
+	)
+	return nil
+}
+
+// refreshMovie brings the shared read models of a movie in line with the
+// catalog, whether the movie was created, changed or deleted.
+func (h *Handler) refreshMovie(ctx context.Context, movieID string) error {
+	if err := h.refreshSuggestion(ctx, movieID); err != nil {
+		return err
+	}
+	return h.refreshEmbedding(ctx, movieID)
+}
+
+// refreshEmbedding recomputes the embedding of a movie from its current
+// text. Deleted movies take their embeddings with them.
+func (h *Handler) refreshEmbedding(ctx context.Context, movieID string) error {
+	if h.repository == nil || h.embedder == nil {
+		return nil
+	}
+
+	movie, err := h.repository.GetMovieByID(ctx, movieID)
+	if errors.Is(err, ErrMovieNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	embedding, err := embedMovie(ctx, h.embedder, movie)
+	if err != nil {
+		return err
+	}
+	if err := h.repository.SetEmbedding(ctx, movieID, embedding); err != nil && !errors.Is(err, ErrMovieNotFound) {
+		return err
+	}
+	return nil
+}
+
+// refreshSuggestion brings the suggestion of a movie in line with the catalog.
+func (h *Handler) refreshSuggestion(ctx context.Context, movieID string) error {
+	if h.repository == nil {
+		return nil
+	}
+
+	if err := h.repository.RefreshSuggestion(ctx, movieID); err != nil {
+		return fmt.Errorf("failed to refresh suggestion: %w", err)
+	}
 	return nil
 }
@@ -0,0 +1,902 @@
+package movies
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"event-driven-go/internal/shared"
+	schema "github.com/nameteos/my-movies-db-schema/mongodb"
+)
+
+type HTTPHandler struct {
+	service *Service
+	logger  *slog.Logger
+}
+
+func NewHTTPHandler(service *Service, logger *slog.Logger) *HTTPHandler {
+	return &HTTPHandler{
+		service: service,
+		logger:  logger.With(slog.String("domain", "movies")),
+	}
+}
+
+func (h *HTTPHandler) RegisterRoutes(router *shared.Router) {
+	router.Handle(shared.Route{
+		Method:   http.MethodPost,
+		Path:     "/movies",
+		Tag:      "movies",
+		Summary:  "Add a movie to the catalog",
+		Request:  MovieRequest{},
+		Response: schema.Movie{},
+		Status:   http.StatusCreated,
+		Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusConflict, http.StatusUnprocessableEntity},
+		Auth:     true,
+	}, h.createMovie)
+	router.Handle(shared.Route{
+		Method:  http.MethodGet,
+		Path:    "/movies",
+		Tag:     "movies",
+		Summary: "Recently added movies, or the result of one of the filters",
+		Query: append([]shared.QueryParam{
+			{Name: "q", Description: "Full text search on titles in every language, overview, cast and genres"},
+			{Name: "genre", Description: "Genre name"},
+			{Name: "year", Type: "integer", Description: "Release year"},
+			{Name: "director", Description: "Director name"},
+			langParam,
+		}, shared.PaginationParams...),
+		Response: MovieListResponse{},
+		Errors:   []int{http.StatusBadRequest},
+	}, h.listMovies)
+	router.Handle(shared.Route{
+		Method:  http.MethodGet,
+		Path:    "/movies/search",
+		Tag:     "movies",
+		Summary: "Movies matching all of the filters, with facet counts of all matches",
+		Query: append([]shared.QueryParam{
+			{Name: "q", Description: "Full text search on titles in every language, overview, cast and genres"},
+			{Name: "genre", Description: "Genre name, repeat for movies of any of several genres"},
+			{Name: "year_from", Type: "integer", Description: "Earliest release year"},
+			{Name: "year_to", Type: "integer", Description: "Latest release year"},
+			{Name: "director", Description: "Director name"},
+			{Name: "cast", Description: "Cast member name, repeat for movies with all of several"},
+			{Name: "runtime_min", Type: "integer", Description: "Shortest runtime in minutes"},
+			{Name: "runtime_max", Type: "integer", Description: "Longest runtime in minutes"},
+			{Name: "language", Description: "ISO 639-1 code of the original language"},
+			{Name: "sort", Description: "relevance (default with q), release_date (default without), title, runtime or added"},
+			langParam,
+		}, shared.PaginationParams...),
+		Response: MovieSearchResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusUnprocessableEntity},
+	}, h.findMovies)
+	router.Handle(shared.Route{
+		Method:  http.MethodGet,
+		Path:    "/movies/autocomplete",
+		Tag:     "movies",
+		Summary: "Suggest movies while a title is typed, most popular first",
+		Query: []shared.QueryParam{
+			{Name: "q", Required: true, Description: "Start of a title word, case and accents ignored"},
+			{Name: "limit", Type: "integer", Description: "Number of suggestions, 1 to 25 (default 10)"},
+		},
+		Response: SuggestionListResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusUnprocessableEntity},
+	}, h.autocomplete)
+	router.Handle(shared.Route{
+		Method:  http.MethodGet,
+		Path:    "/movies/semantic",
+		Tag:     "movies",
+		Summary: "Movies closest in meaning to a free text description",
+		Query: []shared.QueryParam{
+			{Name: "q", Required: true, Description: "Description of the movie, such as its plot or mood"},
+			{Name: "limit", Type: "integer", Description: "Number of movies, 1 to 100 (default 10)"},
+		},
+		Response: MovieMatchListResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusUnprocessableEntity},
+		Auth:     true,
+	}, h.semanticSearch)
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/movies/{id}",
+		Tag:      "movies",
+		Summary:  "Get a movie, in the language asked for if it is translated to it",
+		Query:    []shared.QueryParam{langParam},
+		Response: LocalizedMovie{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+	}, h.getMovie)
+	router.Handle(shared.Route{
+		Method:  http.MethodGet,
+		Path:    "/movies/{id}/similar",
+		Tag:     "movies",
+		Summary: "Movies most alike a movie by their embeddings",
+		Query: []shared.QueryParam{
+			{Name: "limit", Type: "integer", Description: "Number of movies, 1 to 100 (default 10)"},
+		},
+		Response: MovieMatchListResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusUnprocessableEntity},
+	}, h.similarMovies)
+	router.Handle(shared.Route{
+		Method:   http.MethodPut,
+		Path:     "/movies/{id}",
+		Tag:      "movies",
+		Summary:  "Replace the editable fields of a movie",
+		Request:  MovieRequest{},
+		Response: schema.Movie{},
+		Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity},
+		Auth:     true,
+	}, h.updateMovie)
+	router.Handle(shared.Route{
+		Method:  http.MethodDelete,
+		Path:    "/movies/{id}",
+		Tag:     "movies",
+		Summary: "Remove a movie",
+		Status:  http.StatusNoContent,
+		Errors:  []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound},
+		Auth:    true,
+	}, h.deleteMovie)
+	router.Handle(shared.Route{
+		Method:  http.MethodGet,
+		Path:    "/movies/lookup",
+		Tag:     "movies",
+		Summary: "Get a movie by its ID in another catalog",
+		Query: []shared.QueryParam{
+			{Name: string(SourceIMDb), Description: "IMDb title ID such as tt0133093"},
+			{Name: string(SourceTMDb), Type: "integer", Description: "TMDb movie ID"},
+			{Name: string(SourceWikidata), Description: "Wikidata item ID such as Q83495"},
+		},
+		Response: schema.Movie{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound, http.StatusUnprocessableEntity},
+	}, h.getMovieByExternalID)
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/movies/{id}/external-ids",
+		Tag:      "movies",
+		Summary:  "Get the IDs of a movie in other catalogs",
+		Response: ExternalIDs{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+	}, h.getExternalIDs)
+	router.Handle(shared.Route{
+		Method:   http.MethodPut,
+		Path:     "/movies/{id}/external-ids",
+		Tag:      "movies",
+		Summary:  "Replace the IDs of a movie in other catalogs",
+		Request:  ExternalIDs{},
+		Response: ExternalIDs{},
+		Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity},
+		Auth:     true,
+	}, h.setExternalIDs)
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/movies/{id}/revisions",
+		Tag:      "movies",
+		Summary:  "Changes made to the catalog fields of a movie, newest first",
+		Query:    shared.PaginationParams,
+		Response: RevisionListResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound},
+		Auth:     true,
+	}, h.listRevisions)
+	router.Handle(shared.Route{
+		Method:   http.MethodPost,
+		Path:     "/movies/{id}/revisions/{revisionID}/revert",
+		Tag:      "movies",
+		Summary:  "Put the catalog fields of a movie back the way a revision left them",
+		Response: schema.Movie{},
+		Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity},
+		Auth:     true,
+	}, h.revertMovie)
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/movies/{id}/localizations",
+		Tag:      "movies",
+		Summary:  "Get the translations and country releases of a movie",
+		Response: Localizations{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+	}, h.getLocalizations)
+	router.Handle(shared.Route{
+		Method:   http.MethodPut,
+		Path:     "/movies/{id}/localizations",
+		Tag:      "movies",
+		Summary:  "Replace the translations and country releases of a movie",
+		Request:  Localizations{},
+		Response: Localizations{},
+		Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusUnprocessableEntity},
+		Auth:     true,
+	}, h.setLocalizations)
+	router.Handle(shared.Route{
+		Method:   http.MethodPost,
+		Path:     "/movies/{id}/merge",
+		Tag:      "movies",
+		Summary:  "Merge duplicates into a movie, moving watchlists, history and ratings to it",
+		Request:  MergeMoviesRequest{},
+		Response: schema.Movie{},
+		Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity},
+		Auth:     true,
+	}, h.mergeMovies)
+	router.Handle(shared.Route{
+		Method:   http.MethodPost,
+		Path:     "/collections",
+		Tag:      "collections",
+		Summary:  "Add a collection of movies in watching order",
+		Request:  CollectionInput{},
+		Response: Collection{},
+		Status:   http.StatusCreated,
+		Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusUnprocessableEntity},
+		Auth:     true,
+	}, h.createCollection)
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/collections",
+		Tag:      "collections",
+		Summary:  "Collections by name, without their movies",
+		Query:    shared.PaginationParams,
+		Response: CollectionListResponse{},
+		Errors:   []int{http.StatusBadRequest},
+	}, h.listCollections)
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/collections/{id}",
+		Tag:      "collections",
+		Summary:  "Get a collection with its movies in order",
+		Response: Collection{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+	}, h.getCollection)
+	router.Handle(shared.Route{
+		Method:   http.MethodPut,
+		Path:     "/collections/{id}",
+		Tag:      "collections",
+		Summary:  "Replace the name, description and movies of a collection",
+		Request:  CollectionInput{},
+		Response: Collection{},
+		Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusUnprocessableEntity},
+		Auth:     true,
+	}, h.updateCollection)
+	router.Handle(shared.Route{
+		Method:  http.MethodDelete,
+		Path:    "/collections/{id}",
+		Tag:     "collections",
+		Summary: "Remove a collection, keeping its movies",
+		Status:  http.StatusNoContent,
+		Errors:  []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound},
+		Auth:    true,
+	}, h.deleteCollection)
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/movies/{id}/collections",
+		Tag:      "collections",
+		Summary:  "Collections a movie belongs to",
+		Response: MovieCollectionsResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+	}, h.getMovieCollections)
+}
+
+// MovieRequest holds the editable catalog fields of a movie.
+type MovieRequest struct {
+	Title            string                `json:"title"`
+	OriginalTitle    string                `json:"original_title,omitempty"`
+	OriginalLanguage string                `json:"original_language,omitempty"`
+	Overview         string                `json:"overview,omitempty"`
+	Tagline          string                `json:"tagline,omitempty"`
+	Status           string                `json:"status,omitempty"`
+	ReleaseDate      string                `json:"release_date,omitempty"`
+	Runtime          int                   `json:"runtime,omitempty"`
+	Adult            bool                  `json:"adult,omitempty"`
+	Budget           int64                 `json:"budget,omitempty"`
+	Revenue          int64                 `json:"revenue,omitempty"`
+	Genres           []schema.TMDBGenre    `json:"genres,omitempty"`
+	SpokenLanguages  []schema.TMDBLanguage `json:"spoken_languages,omitempty"`
+	PosterPath       string                `json:"poster_path,omitempty"`
+	IMDbID           string                `json:"imdb_id,omitempty"`
+	ExternalID       int                   `json:"external_id,omitempty"`
+}
+
+// langParam overrides the Accept-Language header of the movie reads, for
+// links that keep their language.
+var langParam = shared.QueryParam{
+	Name:        "lang",
+	Description: "Languages to read titles in, like Accept-Language such as de-AT,de;q=0.9 (default the Accept-Language header)",
+}
+
+// acceptLanguage returns the languages the client reads titles in.
+func acceptLanguage(r *http.Request) string {
+	if lang := r.URL.Query().Get(langParam.Name); lang != "" {
+		return lang
+	}
+	return r.Header.Get("Accept-Language")
+}
+
+type MergeMoviesRequest struct {
+	DuplicateIDs []string `json:"duplicate_ids"`
+}
+
+type MovieListResponse struct {
+	Data       []*schema.Movie   `json:"data"`
+	Pagination shared.Pagination `json:"pagination"`
+}
+
+// MovieSearchResponse is a page of the movies matching the filters. Total
+// and the facets cover all matches.
+type MovieSearchResponse struct {
+	Data       []*schema.Movie   `json:"data"`
+	Pagination shared.Pagination `json:"pagination"`
+	Total      int               `json:"total"`
+	Facets     MovieFacets       `json:"facets"`
+}
+
+// SuggestionListResponse holds the suggestions for a prefix.
+type SuggestionListResponse struct {
+	Data []*Suggestion `json:"data"`
+}
+
+type RevisionListResponse struct {
+	Data       []*Revision       `json:"data"`
+	Pagination shared.Pagination `json:"pagination"`
+}
+
+type CollectionListResponse struct {
+	Data       []*Collection     `json:"data"`
+	Pagination shared.Pagination `json:"pagination"`
+}
+
+type MovieCollectionsResponse struct {
+	Data []*Collection `json:"data"`
+}
+
+// MovieMatchListResponse holds movies found by similarity, most similar
+// first.
+type MovieMatchListResponse struct {
+	Data []*MovieMatch `json:"data"`
+}
+
+func (h *HTTPHandler) createMovie(w http.ResponseWriter, r *http.Request) {
+	var request MovieRequest
+	if err := shared.DecodeJSON(w, r, &request); err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if fields := request.validate(); len(fields) > 0 {
+		shared.WriteError(w, r, http.StatusUnprocessableEntity, "invalid movie", fields)
+		return
+	}
+
+	movie := &schema.Movie{}
+	request.applyTo(movie)
+
+	createdMovie, err := h.service.CreateMovie(r.Context(), movie)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusCreated, createdMovie)
+}
+
+// listMovies serves the recent movies, or the result of exactly one of the
+// q, genre, year and director filters.
+func (h *HTTPHandler) listMovies(w http.ResponseWriter, r *http.Request) {
+	page, ok := shared.ParsePageRequest(w, r)
+	if !ok {
+		return
+	}
+
+	query := r.URL.Query()
+	filters := 0
+	for _, key := range []string{"q", "genre", "year", "director"} {
+		if query.Get(key) != "" {
+			filters++
+		}
+	}
+	if filters > 1 {
+		shared.WriteError(w, r, http.StatusBadRequest, "only one of q, genre, year and director may be set", nil)
+		return
+	}
+
+	var (
+		movies *shared.Page[*schema.Movie]
+		err    error
+	)
+	switch {
+	case query.Get("q") != "":
+		movies, err = h.service.SearchMovies(r.Context(), query.Get("q"), page)
+	case query.Get("genre") != "":
+		movies, err = h.service.GetMoviesByGenre(r.Context(), query.Get("genre"), page)
+	case query.Get("year") != "":
+		year, convErr := strconv.Atoi(query.Get("year"))
+		if convErr != nil || year < 1800 || year > 9999 {
+			shared.WriteError(w, r, http.StatusBadRequest, "year must be a four digit year", nil)
+			return
+		}
+		movies, err = h.service.GetMoviesByYear(r.Context(), year, page)
+	case query.Get("director") != "":
+		movies, err = h.service.GetMoviesByDirector(r.Context(), query.Get("director"), page)
+	default:
+		movies, err = h.service.GetRecentMovies(r.Context(), page)
+	}
+	if err == nil {
+		err = h.service.LocalizeMovies(r.Context(), movies.Items, acceptLanguage(r))
+	}
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	w.Header().Add("Vary", "Accept-Language")
+	shared.WriteJSON(w, http.StatusOK, MovieListResponse{
+		Data:       movies.Items,
+		Pagination: shared.PaginationOf(movies, page),
+	})
+}
+
+func (h *HTTPHandler) getMovie(w http.ResponseWriter, r *http.Request) {
+	id, ok := shared.PathObjectID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	movie, err := h.service.GetLocalizedMovie(r.Context(), id, acceptLanguage(r))
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	w.Header().Add("Vary", "Accept-Language")
+	if movie.Locale != "" {
+		w.Header().Set("Content-Language", movie.Locale)
+	}
+	shared.WriteJSON(w, http.StatusOK, movie)
+}
+
+func (h *HTTPHandler) updateMovie(w http.ResponseWriter, r *http.Request) {
+	id, ok := shared.PathObjectID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var request MovieRequest
+	if err := shared.DecodeJSON(w, r, &request); err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if fields := request.validate(); len(fields) > 0 {
+		shared.WriteError(w, r, http.StatusUnprocessableEntity, "invalid movie", fields)
+		return
+	}
+
+	movie, err := h.service.GetMovieByID(r.Context(), id)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+	request.applyTo(movie)
+
+	updatedMovie, err := h.service.UpdateMovie(r.Context(), movie)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, updatedMovie)
+}
+
+func (h *HTTPHandler) deleteMovie(w http.ResponseWriter, r *http.Request) {
+	id, ok := shared.PathObjectID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteMovie(r.Context(), id); err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// getMovieByExternalID looks a movie up by exactly one of the imdb, tmdb and
+// wikidata query parameters.
+func (h *HTTPHandler) getMovieByExternalID(w http.ResponseWriter, r *http.Request) {
+	var source ExternalSource
+	for _, candidate := range ExternalSources {
+		if r.URL.Query().Get(string(candidate)) == "" {
+			continue
+		}
+		if source != "" {
+			shared.WriteError(w, r, http.StatusBadRequest, "only one of imdb, tmdb and wikidata may be set", nil)
+			return
+		}
+		source = candidate
+	}
+	if source == "" {
+		shared.WriteError(w, r, http.StatusBadRequest, "one of imdb, tmdb and wikidata is required", nil)
+		return
+	}
+
+	movie, err := h.service.GetMovieByExternalID(r.Context(), source, r.URL.Query().Get(string(source)))
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, movie)
+}
+
+func (h *HTTPHandler) findMovies(w http.ResponseWriter, r *http.Request) {
+	page, ok := shared.ParsePageRequest(w, r)
+	if !ok {
+		return
+	}
+
+	values := r.URL.Query()
+	query := MovieQuery{
+		Text:     values.Get("q"),
+		Genres:   values["genre"],
+		Director: values.Get("director"),
+		Cast:     values["cast"],
+		Language: values.Get("language"),
+		Sort:     MovieSort(values.Get("sort")),
+		Page:     page,
+
+		AcceptLanguage: acceptLanguage(r),
+	}
+	for key, target := range map[string]*int{
+		"year_from":   &query.YearFrom,
+		"year_to":     &query.YearTo,
+		"runtime_min": &query.RuntimeMin,
+		"runtime_max": &query.RuntimeMax,
+	} {
+		value, err := shared.QueryInt(r, key, 0)
+		if err != nil {
+			shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+			return
+		}
+		*target = value
+	}
+
+	result, err := h.service.FindMovies(r.Context(), query)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	w.Header().Add("Vary", "Accept-Language")
+	shared.WriteJSON(w, http.StatusOK, MovieSearchResponse{
+		Data:       result.Page.Items,
+		Pagination: shared.PaginationOf(result.Page, page),
+		Total:      result.Total,
+		Facets:     result.Facets,
+	})
+}
+
+func (h *HTTPHandler) autocomplete(w http.ResponseWriter, r *http.Request) {
+	limit, err := shared.QueryInt(r, "limit", DefaultSuggestionLimit)
+	if err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	suggestions, err := h.service.Autocomplete(r.Context(), r.URL.Query().Get("q"), limit)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, SuggestionListResponse{Data: suggestions})
+}
+
+func (h *HTTPHandler) similarMovies(w http.ResponseWriter, r *http.Request) {
+	id, ok := shared.PathObjectID(w, r, "id")
+	if !ok {
+		return
+	}
+	limit, err := shared.QueryInt(r, "limit", DefaultSimilarLimit)
+	if err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	matches, err := h.service.SimilarMovies(r.Context(), id, limit)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, MovieMatchListResponse{Data: matches})
+}
+
+func (h *HTTPHandler) semanticSearch(w http.ResponseWriter, r *http.Request) {
+	limit, err := shared.QueryInt(r, "limit", DefaultSimilarLimit)
+	if err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	matches, err := h.service.SemanticSearch(r.Context(), r.URL.Query().Get("q"), limit)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, MovieMatchListResponse{Data: matches})
+}
+
+func (h *HTTPHandler) getExternalIDs(w http.ResponseWriter, r *http.Request) {
+	id, ok := shared.PathObjectID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	ids, err := h.service.GetExternalIDs(r.Context(), id)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, ids)
+}
+
+func (h *HTTPHandler) setExternalIDs(w http.ResponseWriter, r *http.Request) {
+	id, ok := shared.PathObjectID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var request ExternalIDs
+	if err := shared.DecodeJSON(w, r, &request); err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	ids, err := h.service.SetExternalIDs(r.Context(), id, request)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, ids)
+}
+
+func (h *HTTPHandler) listRevisions(w http.ResponseWriter, r *http.Request) {
+	id, ok := shared.PathObjectID(w, r, "id")
+	if !ok {
+		return
+	}
+	page, ok := shared.ParsePageRequest(w, r)
+	if !ok {
+		return
+	}
+
+	revisions, err := h.service.ListRevisions(r.Context(), id, page)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, RevisionListResponse{
+		Data:       revisions.Items,
+		Pagination: shared.PaginationOf(revisions, page),
+	})
+}
+
+func (h *HTTPHandler) revertMovie(w http.ResponseWriter, r *http.Request) {
+	id, ok := shared.PathObjectID(w, r, "id")
+	if !ok {
+		return
+	}
+	revisionID, ok := shared.PathObjectID(w, r, "revisionID")
+	if !ok {
+		return
+	}
+
+	movie, err := h.service.RevertMovie(r.Context(), id, revisionID)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, movie)
+}
+
+func (h *HTTPHandler) getLocalizations(w http.ResponseWriter, r *http.Request) {
+	id, ok := shared.PathObjectID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	localizations, err := h.service.GetLocalizations(r.Context(), id)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, localizations)
+}
+
+func (h *HTTPHandler) setLocalizations(w http.ResponseWriter, r *http.Request) {
+	id, ok := shared.PathObjectID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var request Localizations
+	if err := shared.DecodeJSON(w, r, &request); err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	localizations, err := h.service.SetLocalizations(r.Context(), id, request)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, localizations)
+}
+
+func (h *HTTPHandler) mergeMovies(w http.ResponseWriter, r *http.Request) {
+	id, ok := shared.PathObjectID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var request MergeMoviesRequest
+	if err := shared.DecodeJSON(w, r, &request); err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	movie, err := h.service.MergeMovies(r.Context(), id, request.DuplicateIDs)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, movie)
+}
+
+func (m *MovieRequest) validate() map[string]string {
+	fields := make(map[string]string)
+
+	if strings.TrimSpace(m.Title) == "" {
+		fields["title"] = "must not be empty"
+	}
+	if m.ReleaseDate != "" {
+		if _, err := time.Parse(time.DateOnly, m.ReleaseDate); err != nil {
+			fields["release_date"] = "must be a date formatted as YYYY-MM-DD"
+		}
+	}
+	if m.Runtime < 0 {
+		fields["runtime"] = "must not be negative"
+	}
+	if m.Budget < 0 {
+		fields["budget"] = "must not be negative"
+	}
+	if m.Revenue < 0 {
+		fields["revenue"] = "must not be negative"
+	}
+	if m.IMDbID != "" && !imdbIDPattern.MatchString(m.IMDbID) {
+		fields["imdb_id"] = "must be an IMDb title ID such as tt0133093"
+	}
+	if m.ExternalID < 0 {
+		fields["external_id"] = "must not be negative"
+	}
+
+	return fields
+}
+
+func (m *MovieRequest) applyTo(movie *schema.Movie) {
+	movie.Title = strings.TrimSpace(m.Title)
+	movie.OriginalTitle = m.OriginalTitle
+	movie.OriginalLanguage = m.OriginalLanguage
+	movie.Overview = m.Overview
+	movie.Tagline = m.Tagline
+	movie.Status = m.Status
+	movie.ReleaseDate = m.ReleaseDate
+	movie.Runtime = m.Runtime
+	movie.Adult = m.Adult
+	movie.Budget = m.Budget
+	movie.Revenue = m.Revenue
+	movie.Genres = m.Genres
+	movie.SpokenLanguages = m.SpokenLanguages
+	movie.PosterPath = m.PosterPath
+	movie.IMDbID = m.IMDbID
+	movie.ExternalID = m.ExternalID
+}
+
+func (h *HTTPHandler) createCollection(w http.ResponseWriter, r *http.Request) {
+	var request CollectionInput
+	if err := shared.DecodeJSON(w, r, &request); err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	collection, err := h.service.CreateCollection(r.Context(), request)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusCreated, collection)
+}
+
+func (h *HTTPHandler) listCollections(w http.ResponseWriter, r *http.Request) {
+	page, ok := shared.ParsePageRequest(w, r)
+	if !ok {
+		return
+	}
+
+	collections, err := h.service.ListCollections(r.Context(), page)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, CollectionListResponse{
+		Data:       collections.Items,
+		Pagination: shared.PaginationOf(collections, page),
+	})
+}
+
+func (h *HTTPHandler) getCollection(w http.ResponseWriter, r *http.Request) {
+	id, ok := shared.PathObjectID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	collection, err := h.service.GetCollection(r.Context(), id)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, collection)
+}
+
+func (h *HTTPHandler) updateCollection(w http.ResponseWriter, r *http.Request) {
+	id, ok := shared.PathObjectID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var request CollectionInput
+	if err := shared.DecodeJSON(w, r, &request); err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	collection, err := h.service.UpdateCollection(r.Context(), id, request)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, collection)
+}
+
+func (h *HTTPHandler) deleteCollection(w http.ResponseWriter, r *http.Request) {
+	id, ok := shared.PathObjectID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	if err := h.service.DeleteCollection(r.Context(), id); err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *HTTPHandler) getMovieCollections(w http.ResponseWriter, r *http.Request) {
+	id, ok := shared.PathObjectID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	collections, err := h.service.GetMovieCollections(r.Context(), id)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, MovieCollectionsResponse{Data: collections})
+}
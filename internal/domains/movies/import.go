@@ -0,0 +1,499 @@
+package movies
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"event-driven-go/internal/shared"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// ImportFormat is the file format of a bulk import.
+type ImportFormat string
+
+const (
+	// ImportCSV reads a CSV file with a header row naming the columns.
+	ImportCSV ImportFormat = "csv"
+	// ImportJSONL reads one JSON movie object per line.
+	ImportJSONL ImportFormat = "jsonl"
+	// ImportIMDb reads the public IMDb title.basics TSV dataset.
+	ImportIMDb ImportFormat = "imdb"
+)
+
+const (
+	DefaultImportBatchSize = 1000
+
+	// importLogInterval is how many records pass between progress log lines.
+	importLogInterval = 100_000
+)
+
+// importEditor is the editor of the revisions an import records.
+var importEditor = shared.SystemPrincipal("import").UserID
+
+// importFields are the fields CSV columns and JSONL keys are read into,
+// named as in MovieRequest.
+var importFields = []string{
+	"title", "original_title", "original_language", "overview", "tagline", "status",
+	"release_date", "runtime", "adult", "budget", "revenue", "genres", "spoken_languages",
+	"poster_path", "imdb_id", "external_id", "wikidata_id", "director", "cast",
+}
+
+// ImportOptions describe a bulk import.
+type ImportOptions struct {
+	Format ImportFormat
+	Path   string
+	// Principals is an IMDb title.principals file to take directors from,
+	// whose names are looked up in the IMDb name.basics file Names.
+	Principals string
+	Names      string
+	// Mapping maps movie fields to the CSV columns or JSON keys holding them
+	// when those are not named after the field.
+	Mapping   map[string]string
+	BatchSize int
+	// Progress is a checkpoint file written after every batch. An import
+	// started with an existing checkpoint resumes where it left off.
+	Progress string
+	// Report is a file the rejected records are written to, one JSON object
+	// per line.
+	Report string
+	// DryRun validates the file without writing movies or progress.
+	DryRun bool
+}
+
+func (o ImportOptions) validate() error {
+	switch o.Format {
+	case ImportCSV, ImportJSONL:
+		if o.Principals != "" || o.Names != "" {
+			return fmt.Errorf("principals and names files only apply to the %s format", ImportIMDb)
+		}
+	case ImportIMDb:
+		if len(o.Mapping) > 0 {
+			return fmt.Errorf("field mapping only applies to the %s and %s formats", ImportCSV, ImportJSONL)
+		}
+		if o.Principals != "" && o.Names == "" {
+			return errors.New("a names file is required to resolve the directors of a principals file")
+		}
+	default:
+		return fmt.Errorf("unknown import format %q", o.Format)
+	}
+	if o.Path == "" {
+		return errors.New("no file to import")
+	}
+	if o.BatchSize <= 0 {
+		return errors.New("batch size must be positive")
+	}
+	return nil
+}
+
+// ImportSummary counts the records of an import. Read includes the records
+// that were rejected or skipped as not being movies.
+type ImportSummary struct {
+	Read     int `json:"read"`
+	Inserted int `json:"inserted"`
+	Updated  int `json:"updated"`
+	Rejected int `json:"rejected"`
+	Skipped  int `json:"skipped"`
+}
+
+// ImportedMovie is a movie read from an import file. It is matched to a
+// stored movie by any of its external IDs, and only the fields the file sets
+// are written.
+type ImportedMovie struct {
+	MovieRequest
+	WikidataID string   `json:"wikidata_id,omitempty"`
+	Director   string   `json:"director,omitempty"`
+	Cast       []string `json:"cast,omitempty"`
+
+	// releaseYearOnly marks a release date made up from a year, which is only
+	// written to new movies so that it never replaces an exact date.
+	releaseYearOnly bool
+}
+
+func (m *ImportedMovie) validate() map[string]string {
+	fields := m.MovieRequest.validate()
+
+	if m.WikidataID != "" && !wikidataIDPattern.MatchString(m.WikidataID) {
+		fields["wikidata_id"] = "must be a Wikidata item ID such as Q83495"
+	}
+	if m.externalIDs().isEmpty() {
+		fields["external_id"] = "or imdb_id or wikidata_id is required to match stored movies"
+	}
+
+	return fields
+}
+
+func (m *ImportedMovie) externalIDs() ExternalIDs {
+	return ExternalIDs{IMDb: m.IMDbID, TMDb: m.ExternalID, Wikidata: m.WikidataID}
+}
+
+// key is how the report refers to the movie.
+func (m *ImportedMovie) key() string {
+	switch {
+	case m.IMDbID != "":
+		return m.IMDbID
+	case m.WikidataID != "":
+		return m.WikidataID
+	case m.ExternalID != 0:
+		return fmt.Sprint(m.ExternalID)
+	}
+	return ""
+}
+
+// upsert returns the filter matching the stored movie by any of its IDs
+// and the update setting the fields the file set.
+func (m *ImportedMovie) upsert(now time.Time) (bson.M, bson.M) {
+	var ids bson.A
+	if m.ExternalID != 0 {
+		ids = append(ids, bson.M{fieldExternalID: m.ExternalID})
+	}
+	if m.IMDbID != "" {
+		ids = append(ids, bson.M{fieldIMDbID: m.IMDbID})
+	}
+	if m.WikidataID != "" {
+		ids = append(ids, bson.M{fieldWikidataID: m.WikidataID})
+	}
+
+	set := bson.M{fieldUpdatedAt: now}
+	put := func(key string, value interface{}, ok bool) {
+		if ok {
+			set[key] = value
+		}
+	}
+	put(fieldTitle, m.Title, m.Title != "")
+	put("originaltitle", m.OriginalTitle, m.OriginalTitle != "")
+	put("originallanguage", m.OriginalLanguage, m.OriginalLanguage != "")
+	put("overview", m.Overview, m.Overview != "")
+	put("tagline", m.Tagline, m.Tagline != "")
+	put("status", m.Status, m.Status != "")
+	put("runtime", m.Runtime, m.Runtime != 0)
+	put("adult", m.Adult, m.Adult)
+	put("budget", m.Budget, m.Budget != 0)
+	put("revenue", m.Revenue, m.Revenue != 0)
+	put("genres", m.Genres, len(m.Genres) > 0)
+	put("spokenlanguages", m.SpokenLanguages, len(m.SpokenLanguages) > 0)
+	put("posterpath", m.PosterPath, m.PosterPath != "")
+	put(fieldIMDbID, m.IMDbID, m.IMDbID != "")
+	put(fieldExternalID, m.ExternalID, m.ExternalID != 0)
+	put(fieldWikidataID, m.WikidataID, m.WikidataID != "")
+	put(fieldDirector, m.Director, m.Director != "")
+	put(fieldCast, m.Cast, len(m.Cast) > 0)
+
+	setOnInsert := bson.M{fieldCreatedAt: now}
+	if m.releaseYearOnly {
+		setOnInsert[fieldReleaseDate] = m.ReleaseDate
+	} else {
+		put(fieldReleaseDate, m.ReleaseDate, m.ReleaseDate != "")
+	}
+
+	return bson.M{"$or": ids}, bson.M{"$set": set, "$setOnInsert": setOnInsert}
+}
+
+// importRejection is a line of the validation report.
+type importRejection struct {
+	Record int               `json:"record"`
+	Key    string            `json:"key,omitempty"`
+	Fields map[string]string `json:"fields"`
+}
+
+// importCheckpoint is the progress of an import as of its last written batch.
+type importCheckpoint struct {
+	Format  ImportFormat  `json:"format"`
+	Path    string        `json:"path"`
+	Offsets []int64       `json:"offsets"`
+	Summary ImportSummary `json:"summary"`
+}
+
+// Importer writes movies from large files to the catalog in bulk. It writes
+// through the repository rather than the service: it is run by operators
+// with database access, and publishes a single event for the whole import.
+// The changes it makes to stored movies are recorded as revisions by
+// importEditor.
+type Importer struct {
+	repository Repository
+	eventBus   *shared.EventBus
+	logger     *slog.Logger
+}
+
+func NewImporter(repository Repository, eventBus *shared.EventBus, logger *slog.Logger) *Importer {
+	return &Importer{
+		repository: repository,
+		eventBus:   eventBus,
+		logger:     logger.With(slog.String("domain", "movies"), slog.String("component", "importer")),
+	}
+}
+
+// Import reads the records of the file, rejecting invalid ones into the
+// report and upserting the others in batches. Records of IMDb titles that
+// are not movies are skipped. The returned summary covers earlier runs of
+// a resumed import.
+func (i *Importer) Import(ctx context.Context, options ImportOptions) (ImportSummary, error) {
+	if err := options.validate(); err != nil {
+		return ImportSummary{}, err
+	}
+
+	checkpoint, err := i.loadCheckpoint(options)
+	if err != nil {
+		return ImportSummary{}, err
+	}
+	resumed := checkpoint.Offsets != nil
+	if resumed {
+		i.logger.InfoContext(ctx, "resuming import", slog.String("path", options.Path), slog.Int("records", checkpoint.Summary.Read))
+	}
+
+	source, err := openImportSource(options, checkpoint.Offsets)
+	if err != nil {
+		return ImportSummary{}, err
+	}
+	defer source.Close()
+
+	report, err := openImportReport(options.Report, resumed)
+	if err != nil {
+		return ImportSummary{}, err
+	}
+	defer report.close()
+
+	summary := checkpoint.Summary
+	batch := make([]*ImportedMovie, 0, options.BatchSize)
+	// batchRecords are the record numbers of the movies in the batch
+	batchRecords := make([]int, 0, options.BatchSize)
+
+	// flush writes the batch and the rejections read along with it, then
+	// records that the import has got past them
+	flush := func() error {
+		if !options.DryRun && len(batch) > 0 {
+			result, err := i.repository.UpsertMovies(ctx, batch, importEditor)
+			if err != nil {
+				return err
+			}
+			summary.Inserted += result.Inserted
+			summary.Updated += result.Updated
+			for _, position := range result.Conflicts {
+				summary.Rejected++
+				report.add(importRejection{
+					Record: batchRecords[position],
+					Key:    batch[position].key(),
+					Fields: map[string]string{"record": "has IDs that belong to different stored movies"},
+				})
+			}
+		}
+		batch = batch[:0]
+		batchRecords = batchRecords[:0]
+
+		if err := report.flush(); err != nil {
+			return err
+		}
+		if options.DryRun || options.Progress == "" {
+			return nil
+		}
+		checkpoint.Offsets = source.offsets()
+		checkpoint.Summary = summary
+		return saveCheckpoint(options.Progress, checkpoint)
+	}
+
+	for {
+		record, err := source.next()
+		if err != nil {
+			return summary, fmt.Errorf("failed to read record %d: %w", summary.Read+1, err)
+		}
+		if record == nil {
+			break
+		}
+
+		summary.Read++
+		if summary.Read%importLogInterval == 0 {
+			i.logger.InfoContext(ctx, "import progress",
+				slog.Int("read", summary.Read),
+				slog.Int("inserted", summary.Inserted),
+				slog.Int("updated", summary.Updated),
+				slog.Int("rejected", summary.Rejected),
+			)
+		}
+
+		if record.skip {
+			summary.Skipped++
+			continue
+		}
+		fields := record.fields
+		if len(fields) == 0 {
+			fields = record.movie.validate()
+		}
+		if len(fields) > 0 {
+			summary.Rejected++
+			report.add(importRejection{Record: summary.Read, Key: record.movie.key(), Fields: fields})
+			continue
+		}
+
+		batch = append(batch, record.movie)
+		batchRecords = append(batchRecords, summary.Read)
+		if len(batch) == options.BatchSize {
+			if err := flush(); err != nil {
+				return summary, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return summary, err
+	}
+
+	if options.DryRun {
+		return summary, nil
+	}
+	if options.Progress != "" {
+		if err := os.Remove(options.Progress); err != nil {
+			i.logger.WarnContext(ctx, "failed to remove import progress file", slog.Any("error", err))
+		}
+	}
+
+	event := NewMovieImportCompletedEvent(options.Format, filepath.Base(options.Path), summary)
+	if err := i.eventBus.Publish(ctx, event); err != nil {
+		// The movies are written, so the import itself succeeded
+		i.logger.WarnContext(ctx, "failed to publish import completed event", slog.Any("error", err))
+	}
+
+	return summary, nil
+}
+
+// loadCheckpoint returns the checkpoint of an interrupted import of the
+// same file, or an empty one to start from.
+func (i *Importer) loadCheckpoint(options ImportOptions) (importCheckpoint, error) {
+	fresh := importCheckpoint{Format: options.Format, Path: options.Path}
+	if options.Progress == "" || options.DryRun {
+		return fresh, nil
+	}
+
+	raw, err := os.ReadFile(options.Progress)
+	if errors.Is(err, os.ErrNotExist) {
+		return fresh, nil
+	}
+	if err != nil {
+		return fresh, fmt.Errorf("failed to read import progress: %w", err)
+	}
+
+	var checkpoint importCheckpoint
+	if err := json.Unmarshal(raw, &checkpoint); err != nil {
+		return fresh, fmt.Errorf("failed to read import progress: %w", err)
+	}
+	if checkpoint.Format != options.Format || checkpoint.Path != options.Path {
+		return fresh, fmt.Errorf("progress file %s belongs to the %s import of %s", options.Progress, checkpoint.Format, checkpoint.Path)
+	}
+
+	return checkpoint, nil
+}
+
+// saveCheckpoint replaces the progress file in one rename, so that an
+// interrupted write leaves the previous checkpoint in place.
+func saveCheckpoint(path string, checkpoint importCheckpoint) error {
+	raw, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+
+	temporary := path + ".tmp"
+	if err := os.WriteFile(temporary, raw, 0o644); err != nil {
+		return fmt.Errorf("failed to write import progress: %w", err)
+	}
+	if err := os.Rename(temporary, path); err != nil {
+		return fmt.Errorf("failed to write import progress: %w", err)
+	}
+
+	return nil
+}
+
+// importReport buffers the rejections of a batch until it is written, so
+// that a resumed import does not report records twice.
+type importReport struct {
+	file    *os.File
+	writer  *bufio.Writer
+	pending []importRejection
+}
+
+func openImportReport(path string, resumed bool) (*importReport, error) {
+	if path == "" {
+		return &importReport{}, nil
+	}
+
+	flags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if resumed {
+		flags = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	}
+	file, err := os.OpenFile(path, flags, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open import report: %w", err)
+	}
+
+	return &importReport{file: file, writer: bufio.NewWriter(file)}, nil
+}
+
+func (r *importReport) add(rejection importRejection) {
+	if r.file != nil {
+		r.pending = append(r.pending, rejection)
+	}
+}
+
+func (r *importReport) flush() error {
+	if r.file == nil {
+		return nil
+	}
+
+	encoder := json.NewEncoder(r.writer)
+	for _, rejection := range r.pending {
+		if err := encoder.Encode(rejection); err != nil {
+			return fmt.Errorf("failed to write import report: %w", err)
+		}
+	}
+	r.pending = r.pending[:0]
+
+	if err := r.writer.Flush(); err != nil {
+		return fmt.Errorf("failed to write import report: %w", err)
+	}
+	return nil
+}
+
+func (r *importReport) close() {
+	if r.file != nil {
+		_ = r.file.Close()
+	}
+}
+
+// fieldMapping resolves the movie field a CSV column or JSON key holds.
+type fieldMapping struct {
+	bySource map[string]string
+	byField  map[string]string
+}
+
+func newFieldMapping(mapping map[string]string) (fieldMapping, error) {
+	m := fieldMapping{bySource: make(map[string]string), byField: mapping}
+	for field, source := range mapping {
+		if !slices.Contains(importFields, field) {
+			return m, fmt.Errorf("cannot map %q: not a movie field", field)
+		}
+		if other, ok := m.bySource[source]; ok {
+			return m, fmt.Errorf("%q is mapped to both %s and %s", source, other, field)
+		}
+		m.bySource[source] = field
+	}
+	return m, nil
+}
+
+// fieldOf returns the field a column holds, or "" when it holds none. A
+// column named after a field that is mapped to another column is ignored.
+func (m fieldMapping) fieldOf(column string) string {
+	if field, ok := m.bySource[column]; ok {
+		return field
+	}
+	if _, mapped := m.byField[column]; mapped {
+		return ""
+	}
+	if slices.Contains(importFields, column) {
+		return column
+	}
+	return ""
+}
@@ -0,0 +1,542 @@
+package movies
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	schema "github.com/nameteos/my-movies-db-schema/mongodb"
+)
+
+// importRecord is a record read from an import file. fields holds the
+// problems converting it to a movie.
+type importRecord struct {
+	movie  *ImportedMovie
+	fields map[string]string
+	skip   bool
+}
+
+// importSource reads the records of an import file.
+type importSource interface {
+	// next returns the next record, or nil after the last.
+	next() (*importRecord, error)
+	// offsets are the byte offsets of the input files to resume reading
+	// from after the records returned so far.
+	offsets() []int64
+	Close() error
+}
+
+func openImportSource(options ImportOptions, offsets []int64) (importSource, error) {
+	offset := func(i int) int64 {
+		if i < len(offsets) {
+			return offsets[i]
+		}
+		return 0
+	}
+
+	switch options.Format {
+	case ImportCSV:
+		mapping, err := newFieldMapping(options.Mapping)
+		if err != nil {
+			return nil, err
+		}
+		return openCSVSource(options.Path, offset(0), mapping)
+	case ImportJSONL:
+		mapping, err := newFieldMapping(options.Mapping)
+		if err != nil {
+			return nil, err
+		}
+		lines, err := openLineReader(options.Path, offset(0))
+		if err != nil {
+			return nil, err
+		}
+		return &jsonlSource{lines: lines, mapping: mapping}, nil
+	default:
+		return openIMDbSource(options, offset(0), offset(1))
+	}
+}
+
+// openInput opens a file for reading from offset. Gzip compressed files,
+// as the IMDb datasets are published, are decompressed up to the offset.
+func openInput(path string, offset int64) (io.ReadCloser, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasSuffix(path, ".gz") {
+		if _, err := file.Seek(offset, io.SeekStart); err != nil {
+			_ = file.Close()
+			return nil, err
+		}
+		return file, nil
+	}
+
+	decompressed, err := gzip.NewReader(file)
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+	if _, err := io.CopyN(io.Discard, decompressed, offset); err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf("failed to skip to offset %d of %s: %w", offset, path, err)
+	}
+
+	return gzipFile{Reader: decompressed, file: file}, nil
+}
+
+type gzipFile struct {
+	*gzip.Reader
+	file *os.File
+}
+
+func (f gzipFile) Close() error {
+	return errors.Join(f.Reader.Close(), f.file.Close())
+}
+
+// lineReader reads a file line by line, keeping track of the offset of the
+// next line.
+type lineReader struct {
+	input  io.ReadCloser
+	reader *bufio.Reader
+	offset int64
+}
+
+func openLineReader(path string, offset int64) (*lineReader, error) {
+	input, err := openInput(path, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lineReader{input: input, reader: bufio.NewReaderSize(input, 1<<16), offset: offset}, nil
+}
+
+// next returns the next line without its line ending, or io.EOF after the last.
+func (r *lineReader) next() (string, error) {
+	line, err := r.reader.ReadString('\n')
+	if err != nil && (!errors.Is(err, io.EOF) || line == "") {
+		return "", err
+	}
+	r.offset += int64(len(line))
+
+	return strings.TrimRight(line, "\r\n"), nil
+}
+
+func (r *lineReader) Close() error {
+	return r.input.Close()
+}
+
+// csvSource reads a CSV file whose header row names the columns.
+type csvSource struct {
+	input  io.ReadCloser
+	reader *csv.Reader
+	// base is the file offset the reader started at
+	base    int64
+	columns []string
+}
+
+func openCSVSource(path string, offset int64, mapping fieldMapping) (*csvSource, error) {
+	input, err := openInput(path, 0)
+	if err != nil {
+		return nil, err
+	}
+	reader := csv.NewReader(input)
+	header, err := reader.Read()
+	if err != nil {
+		_ = input.Close()
+		return nil, fmt.Errorf("failed to read the CSV header: %w", err)
+	}
+
+	columns := make([]string, len(header))
+	for i, column := range header {
+		columns[i] = mapping.fieldOf(strings.TrimSpace(column))
+	}
+
+	if offset > 0 {
+		// The header has to be read from the start in any case
+		_ = input.Close()
+		if input, err = openInput(path, offset); err != nil {
+			return nil, err
+		}
+		reader = csv.NewReader(input)
+	}
+	reader.FieldsPerRecord = len(header)
+
+	return &csvSource{input: input, reader: reader, base: offset, columns: columns}, nil
+}
+
+func (s *csvSource) next() (*importRecord, error) {
+	row, err := s.reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+
+	record := &importRecord{movie: &ImportedMovie{}, fields: make(map[string]string)}
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		record.fields["record"] = parseErr.Err.Error()
+		return record, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for i, value := range row {
+		if s.columns[i] == "" || value == "" {
+			continue
+		}
+		if problem := setImportField(record.movie, s.columns[i], value); problem != "" {
+			record.fields[s.columns[i]] = problem
+		}
+	}
+
+	return record, nil
+}
+
+func (s *csvSource) offsets() []int64 {
+	return []int64{s.base + s.reader.InputOffset()}
+}
+
+func (s *csvSource) Close() error {
+	return s.input.Close()
+}
+
+// setImportField sets a field from its text in a CSV file, returning what is
+// wrong with the text if it cannot be converted. Lists are comma separated.
+func setImportField(movie *ImportedMovie, field, value string) string {
+	var err error
+	switch field {
+	case "title":
+		movie.Title = value
+	case "original_title":
+		movie.OriginalTitle = value
+	case "original_language":
+		movie.OriginalLanguage = value
+	case "overview":
+		movie.Overview = value
+	case "tagline":
+		movie.Tagline = value
+	case "status":
+		movie.Status = value
+	case "release_date":
+		movie.ReleaseDate = value
+	case "poster_path":
+		movie.PosterPath = value
+	case "imdb_id":
+		movie.IMDbID = value
+	case "wikidata_id":
+		movie.WikidataID = value
+	case "director":
+		movie.Director = value
+	case "cast":
+		movie.Cast = splitList(value)
+	case "runtime":
+		movie.Runtime, err = strconv.Atoi(value)
+	case "external_id":
+		movie.ExternalID, err = strconv.Atoi(value)
+	case "budget":
+		movie.Budget, err = strconv.ParseInt(value, 10, 64)
+	case "revenue":
+		movie.Revenue, err = strconv.ParseInt(value, 10, 64)
+	case "adult":
+		if movie.Adult, err = strconv.ParseBool(value); err != nil {
+			return "must be true or false"
+		}
+	case "genres":
+		for _, name := range splitList(value) {
+			movie.Genres = append(movie.Genres, schema.TMDBGenre{Name: name})
+		}
+	case "spoken_languages":
+		for _, code := range splitList(value) {
+			movie.SpokenLanguages = append(movie.SpokenLanguages, schema.TMDBLanguage{ISO6391: code})
+		}
+	}
+
+	if err != nil {
+		return "must be a whole number"
+	}
+	return ""
+}
+
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// jsonlSource reads one JSON movie object per line, skipping blank lines.
+type jsonlSource struct {
+	lines   *lineReader
+	mapping fieldMapping
+}
+
+func (s *jsonlSource) next() (*importRecord, error) {
+	line, err := s.lines.next()
+	for err == nil && strings.TrimSpace(line) == "" {
+		line, err = s.lines.next()
+	}
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	record := &importRecord{movie: &ImportedMovie{}}
+	raw := []byte(line)
+	if len(s.mapping.byField) > 0 {
+		if raw, err = s.mapKeys(raw); err != nil {
+			record.fields = map[string]string{"record": "must be a JSON object"}
+			return record, nil
+		}
+	}
+
+	if err := json.Unmarshal(raw, record.movie); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) && typeErr.Field != "" {
+			record.fields = map[string]string{typeErr.Field: "has the wrong JSON type"}
+		} else {
+			record.fields = map[string]string{"record": "must be a JSON object"}
+		}
+	}
+
+	return record, nil
+}
+
+// mapKeys renames the keys of a JSON object to the fields they hold.
+func (s *jsonlSource) mapKeys(raw []byte) ([]byte, error) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &object); err != nil {
+		return nil, err
+	}
+
+	mapped := make(map[string]json.RawMessage, len(object))
+	for key, value := range object {
+		if field := s.mapping.fieldOf(key); field != "" {
+			mapped[field] = value
+		}
+	}
+	return json.Marshal(mapped)
+}
+
+func (s *jsonlSource) offsets() []int64 {
+	return []int64{s.lines.offset}
+}
+
+func (s *jsonlSource) Close() error {
+	return s.lines.Close()
+}
+
+// imdbNull is how the IMDb datasets write a missing value.
+const imdbNull = `\N`
+
+// imdbSource reads the IMDb title.basics dataset, joining the directors from
+// title.principals. Both files are sorted by title ID, so the principals are
+// read alongside the titles instead of being held in memory.
+type imdbSource struct {
+	basics     *lineReader
+	principals *lineReader
+	// peeked is the principals row following those joined so far, read from
+	// peekedOffset
+	peeked       []string
+	peekedOffset int64
+	names        map[string]string
+}
+
+func openIMDbSource(options ImportOptions, basicsOffset, principalsOffset int64) (*imdbSource, error) {
+	basics, err := openLineReader(options.Path, basicsOffset)
+	if err != nil {
+		return nil, err
+	}
+	source := &imdbSource{basics: basics}
+	if options.Principals == "" {
+		return source, nil
+	}
+
+	if source.names, err = loadIMDbDirectorNames(options.Names); err != nil {
+		_ = source.Close()
+		return nil, fmt.Errorf("failed to read names: %w", err)
+	}
+	if source.principals, err = openLineReader(options.Principals, principalsOffset); err != nil {
+		_ = source.Close()
+		return nil, err
+	}
+
+	return source, nil
+}
+
+// loadIMDbDirectorNames reads the names of the people name.basics lists as
+// directors, which is a small share of the file.
+func loadIMDbDirectorNames(path string) (map[string]string, error) {
+	lines, err := openLineReader(path, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer lines.Close()
+
+	names := make(map[string]string)
+	for {
+		line, err := lines.next()
+		if errors.Is(err, io.EOF) {
+			return names, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		// nconst, primaryName, birthYear, deathYear, primaryProfession, knownForTitles
+		columns := strings.Split(line, "\t")
+		if len(columns) < 5 || !strings.Contains(columns[4], "director") {
+			continue
+		}
+		names[columns[0]] = columns[1]
+	}
+}
+
+func (s *imdbSource) next() (*importRecord, error) {
+	var columns []string
+	for {
+		line, err := s.basics.next()
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if columns = strings.Split(line, "\t"); columns[0] != "tconst" {
+			break
+		}
+	}
+
+	// tconst, titleType, primaryTitle, originalTitle, isAdult, startYear,
+	// endYear, runtimeMinutes, genres
+	if len(columns) != 9 {
+		return &importRecord{
+			movie:  &ImportedMovie{},
+			fields: map[string]string{"record": fmt.Sprintf("has %d columns instead of 9", len(columns))},
+		}, nil
+	}
+	if columns[1] != "movie" {
+		return &importRecord{skip: true}, nil
+	}
+
+	value := func(i int) string {
+		if columns[i] == imdbNull {
+			return ""
+		}
+		return columns[i]
+	}
+
+	record := &importRecord{movie: &ImportedMovie{}, fields: make(map[string]string)}
+	movie := record.movie
+	movie.IMDbID = columns[0]
+	movie.Title = value(2)
+	movie.OriginalTitle = value(3)
+	movie.Adult = value(4) == "1"
+	if year := value(5); year != "" {
+		if _, err := strconv.Atoi(year); err != nil || len(year) != 4 {
+			record.fields["release_date"] = "startYear must be a year"
+		}
+		movie.ReleaseDate = year + "-01-01"
+		movie.releaseYearOnly = true
+	}
+	if runtime := value(7); runtime != "" {
+		if problem := setImportField(movie, "runtime", runtime); problem != "" {
+			record.fields["runtime"] = problem
+		}
+	}
+	if genres := value(8); genres != "" {
+		setImportField(movie, "genres", genres)
+	}
+
+	if s.principals != nil {
+		director, err := s.directorOf(movie.IMDbID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read principals: %w", err)
+		}
+		movie.Director = director
+	}
+
+	return record, nil
+}
+
+// directorOf reads the principals up to those of the title, returning the
+// first of its directors with a known name.
+func (s *imdbSource) directorOf(titleID string) (string, error) {
+	var director string
+	for {
+		row, err := s.peek()
+		if err != nil || row == nil {
+			return director, err
+		}
+		if compareIMDbIDs(row[0], titleID) > 0 {
+			return director, nil
+		}
+		s.peeked = nil
+
+		// tconst, ordering, nconst, category, job, characters
+		if row[0] == titleID && director == "" && row[3] == "director" {
+			director = s.names[row[2]]
+		}
+	}
+}
+
+func (s *imdbSource) peek() ([]string, error) {
+	for s.peeked == nil {
+		s.peekedOffset = s.principals.offset
+		line, err := s.principals.next()
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if row := strings.Split(line, "\t"); len(row) >= 4 && row[0] != "tconst" {
+			s.peeked = row
+		}
+	}
+	return s.peeked, nil
+}
+
+// compareIMDbIDs orders IMDb IDs numerically, which the datasets are sorted
+// by: IDs are zero padded to 7 digits and longer IDs are greater.
+func compareIMDbIDs(a, b string) int {
+	if len(a) != len(b) {
+		return len(a) - len(b)
+	}
+	return strings.Compare(a, b)
+}
+
+func (s *imdbSource) offsets() []int64 {
+	if s.principals == nil {
+		return []int64{s.basics.offset}
+	}
+
+	principalsOffset := s.principals.offset
+	if s.peeked != nil {
+		principalsOffset = s.peekedOffset
+	}
+	return []int64{s.basics.offset, principalsOffset}
+}
+
+func (s *imdbSource) Close() error {
+	var err error
+	if s.basics != nil {
+		err = s.basics.Close()
+	}
+	if s.principals != nil {
+		err = errors.Join(err, s.principals.Close())
+	}
+	return err
+}
@@ -0,0 +1,476 @@
+package movies
+
+import (
+	"bufio"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"event-driven-go/internal/shared"
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	schema "github.com/nameteos/my-movies-db-schema/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+var discardLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// writeFixture writes the content to a file in a temporary directory,
+// compressing it when the name ends in .gz.
+func writeFixture(t *testing.T, name, content string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	file, err := os.Create(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	var writer io.Writer = file
+	if strings.HasSuffix(name, ".gz") {
+		compressed := gzip.NewWriter(file)
+		defer compressed.Close()
+		writer = compressed
+	}
+	if _, err := io.WriteString(writer, content); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// readAll returns the records of the source up to the last.
+func readAll(t *testing.T, source importSource) []*importRecord {
+	t.Helper()
+	defer source.Close()
+
+	var records []*importRecord
+	for {
+		record, err := source.next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if record == nil {
+			return records
+		}
+		records = append(records, record)
+	}
+}
+
+func TestImportOptionsValidate(t *testing.T) {
+	valid := ImportOptions{Format: ImportCSV, Path: "movies.csv", BatchSize: 10}
+
+	tests := []struct {
+		name    string
+		options func(*ImportOptions)
+		valid   bool
+	}{
+		{name: "csv", options: func(*ImportOptions) {}, valid: true},
+		{name: "jsonl with mapping", options: func(o *ImportOptions) { o.Format = ImportJSONL; o.Mapping = map[string]string{"title": "name"} }, valid: true},
+		{name: "imdb with principals", options: func(o *ImportOptions) { o.Format = ImportIMDb; o.Principals = "p.tsv"; o.Names = "n.tsv" }, valid: true},
+		{name: "unknown format", options: func(o *ImportOptions) { o.Format = "xml" }},
+		{name: "no path", options: func(o *ImportOptions) { o.Path = "" }},
+		{name: "no batch size", options: func(o *ImportOptions) { o.BatchSize = 0 }},
+		{name: "principals with csv", options: func(o *ImportOptions) { o.Principals = "p.tsv"; o.Names = "n.tsv" }},
+		{name: "mapping with imdb", options: func(o *ImportOptions) { o.Format = ImportIMDb; o.Mapping = map[string]string{"title": "name"} }},
+		{name: "principals without names", options: func(o *ImportOptions) { o.Format = ImportIMDb; o.Principals = "p.tsv" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options := valid
+			tt.options(&options)
+			if err := options.validate(); (err == nil) != tt.valid {
+				t.Errorf("got %v, want valid %t", err, tt.valid)
+			}
+		})
+	}
+}
+
+func TestFieldMapping(t *testing.T) {
+	mapping, err := newFieldMapping(map[string]string{"title": "name", "release_date": "released"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]string{
+		"name":     "title",
+		"released": "release_date",
+		// Named after a field that is mapped to another column
+		"title":   "",
+		"runtime": "runtime",
+		"rating":  "",
+	}
+	for column, want := range tests {
+		if got := mapping.fieldOf(column); got != want {
+			t.Errorf("fieldOf(%q) = %q, want %q", column, got, want)
+		}
+	}
+}
+
+func TestFieldMappingConflicts(t *testing.T) {
+	tests := map[string]map[string]string{
+		"unknown field":       {"rating": "score"},
+		"column mapped twice": {"title": "name", "original_title": "name"},
+	}
+
+	for name, mapping := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := newFieldMapping(mapping); err == nil {
+				t.Error("got no error")
+			}
+		})
+	}
+}
+
+func TestImportedMovieUpsert(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("exact release date", func(t *testing.T) {
+		movie := &ImportedMovie{
+			MovieRequest: MovieRequest{Title: "Alien", ReleaseDate: "1979-05-25", IMDbID: "tt0078748", ExternalID: 348},
+			Cast:         []string{"Sigourney Weaver"},
+		}
+
+		filter, update := movie.upsert(now)
+
+		wantFilter := bson.M{"$or": bson.A{bson.M{fieldExternalID: 348}, bson.M{fieldIMDbID: "tt0078748"}}}
+		if !reflect.DeepEqual(filter, wantFilter) {
+			t.Errorf("got filter %v, want %v", filter, wantFilter)
+		}
+		wantSet := bson.M{
+			fieldUpdatedAt: now, fieldTitle: "Alien", fieldReleaseDate: "1979-05-25",
+			fieldIMDbID: "tt0078748", fieldExternalID: 348, fieldCast: []string{"Sigourney Weaver"},
+		}
+		if !reflect.DeepEqual(update["$set"], wantSet) {
+			t.Errorf("got $set %v, want %v", update["$set"], wantSet)
+		}
+		if !reflect.DeepEqual(update["$setOnInsert"], bson.M{fieldCreatedAt: now}) {
+			t.Errorf("got $setOnInsert %v", update["$setOnInsert"])
+		}
+	})
+
+	t.Run("year-only release date", func(t *testing.T) {
+		movie := &ImportedMovie{MovieRequest: MovieRequest{Title: "Alien", ReleaseDate: "1979-01-01", IMDbID: "tt0078748"}, releaseYearOnly: true}
+
+		_, update := movie.upsert(now)
+
+		if _, ok := update["$set"].(bson.M)[fieldReleaseDate]; ok {
+			t.Error("a year-only release date replaces stored dates")
+		}
+		wantInsert := bson.M{fieldCreatedAt: now, fieldReleaseDate: "1979-01-01"}
+		if !reflect.DeepEqual(update["$setOnInsert"], wantInsert) {
+			t.Errorf("got $setOnInsert %v, want %v", update["$setOnInsert"], wantInsert)
+		}
+	})
+}
+
+func TestCSVSource(t *testing.T) {
+	path := writeFixture(t, "movies.csv", `name,imdb_id,runtime,genres,rating,adult
+Alien,tt0078748,117,"Horror, Science Fiction",8.5,false
+Heat,tt0113277,two hours,Crime,8.3,maybe
+"Broken,tt1
+Se7en,tt0114369,127,,8.6,
+`)
+
+	source, err := openImportSource(ImportOptions{Format: ImportCSV, Path: path, Mapping: map[string]string{"title": "name"}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	records := readAll(t, source)
+	if len(records) != 3 {
+		t.Fatalf("read %d records, want 3", len(records))
+	}
+
+	alien := records[0].movie
+	if len(records[0].fields) > 0 {
+		t.Errorf("valid row has problems %v", records[0].fields)
+	}
+	wantGenres := []schema.TMDBGenre{{Name: "Horror"}, {Name: "Science Fiction"}}
+	if alien.Title != "Alien" || alien.IMDbID != "tt0078748" || alien.Runtime != 117 || !reflect.DeepEqual(alien.Genres, wantGenres) {
+		t.Errorf("got %+v", alien)
+	}
+
+	wantProblems := map[string]string{"runtime": "must be a whole number", "adult": "must be true or false"}
+	if !reflect.DeepEqual(records[1].fields, wantProblems) {
+		t.Errorf("got problems %v, want %v", records[1].fields, wantProblems)
+	}
+
+	// The unterminated quote swallows the rest of the file into one record
+	if _, ok := records[2].fields["record"]; !ok {
+		t.Errorf("malformed row has problems %v, want a record problem", records[2].fields)
+	}
+}
+
+func TestJSONLSource(t *testing.T) {
+	path := writeFixture(t, "movies.jsonl", `{"name": "Alien", "imdb_id": "tt0078748", "genres": [{"name": "Horror"}]}
+
+{"name": "Heat", "runtime": "170"}
+["not", "an", "object"]
+{"title": "Ignored", "wikidata_id": "Q83495"}
+`)
+
+	source, err := openImportSource(ImportOptions{Format: ImportJSONL, Path: path, Mapping: map[string]string{"title": "name"}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	records := readAll(t, source)
+	if len(records) != 4 {
+		t.Fatalf("read %d records, want 4 without the blank line", len(records))
+	}
+
+	if alien := records[0].movie; alien.Title != "Alien" || alien.IMDbID != "tt0078748" || len(alien.Genres) != 1 {
+		t.Errorf("got %+v", alien)
+	}
+	if problem := records[1].fields["runtime"]; problem == "" {
+		t.Errorf("got problems %v, want one with the runtime", records[1].fields)
+	}
+	if problem := records[2].fields["record"]; problem == "" {
+		t.Errorf("got problems %v, want one with the record", records[2].fields)
+	}
+	// title is mapped to name, so a title key is ignored
+	if ignored := records[3].movie; ignored.Title != "" || ignored.WikidataID != "Q83495" {
+		t.Errorf("got %+v", ignored)
+	}
+}
+
+const imdbBasics = "tconst\ttitleType\tprimaryTitle\toriginalTitle\tisAdult\tstartYear\tendYear\truntimeMinutes\tgenres\n" +
+	"tt0078748\tmovie\tAlien\tAlien\t0\t1979\t\\N\t117\tHorror,Sci-Fi\n" +
+	"tt0090180\ttvSeries\tSome Show\tSome Show\t0\t1986\t1990\t30\tComedy\n" +
+	"tt0113277\tmovie\tHeat\tHeat\t0\t95\t\\N\t\\N\t\\N\n" +
+	"tt0114369\tmovie\tSe7en\n" +
+	"tt10872600\tmovie\tSpider-Man: No Way Home\tSpider-Man: No Way Home\t0\t2021\t\\N\t148\tAction\n"
+
+const imdbPrincipals = "tconst\tordering\tnconst\tcategory\tjob\tcharacters\n" +
+	"tt0078748\t1\tnm0000244\tactress\t\\N\t[\"Ripley\"]\n" +
+	"tt0078748\t2\tnm0000631\tdirector\t\\N\t\\N\n" +
+	"tt0113277\t1\tnm0000520\tdirector\t\\N\t\\N\n" +
+	"tt10872600\t1\tnm9999999\tdirector\t\\N\t\\N\n" +
+	"tt10872600\t2\tnm0946734\tdirector\t\\N\t\\N\n"
+
+const imdbNames = "nconst\tprimaryName\tbirthYear\tdeathYear\tprimaryProfession\tknownForTitles\n" +
+	"nm0000244\tSigourney Weaver\t1949\t\\N\tactress,producer\ttt0078748\n" +
+	"nm0000631\tRidley Scott\t1937\t\\N\tproducer,director\ttt0078748\n" +
+	"nm0000520\tMichael Mann\t1943\t\\N\tdirector,writer\ttt0113277\n" +
+	"nm0946734\tJon Watts\t1981\t\\N\tdirector,writer\ttt10872600\n"
+
+func TestIMDbSource(t *testing.T) {
+	options := ImportOptions{
+		Format:     ImportIMDb,
+		Path:       writeFixture(t, "title.basics.tsv.gz", imdbBasics),
+		Principals: writeFixture(t, "title.principals.tsv", imdbPrincipals),
+		Names:      writeFixture(t, "name.basics.tsv.gz", imdbNames),
+	}
+
+	source, err := openImportSource(options, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	records := readAll(t, source)
+	if len(records) != 5 {
+		t.Fatalf("read %d records, want 5", len(records))
+	}
+
+	alien := records[0].movie
+	if alien.Title != "Alien" || alien.ReleaseDate != "1979-01-01" || !alien.releaseYearOnly ||
+		alien.Runtime != 117 || alien.Director != "Ridley Scott" || len(alien.Genres) != 2 {
+		t.Errorf("got %+v", alien)
+	}
+	if !records[1].skip {
+		t.Error("a TV series was not skipped")
+	}
+	if problem := records[2].fields["release_date"]; problem == "" {
+		t.Errorf("got problems %v, want one with the release date", records[2].fields)
+	}
+	if records[2].movie.Director != "Michael Mann" {
+		t.Errorf("got director %q, want Michael Mann", records[2].movie.Director)
+	}
+	if problem := records[3].fields["record"]; problem == "" {
+		t.Errorf("got problems %v, want one with the record", records[3].fields)
+	}
+	// Directors without a known name are passed over
+	if director := records[4].movie.Director; director != "Jon Watts" {
+		t.Errorf("got director %q, want Jon Watts", director)
+	}
+}
+
+// upsertRepository records the movies upserted into it, failing the call
+// numbered failOn.
+type upsertRepository struct {
+	Repository
+	upserted  []string
+	conflicts []string
+	calls     int
+	failOn    int
+}
+
+func (r *upsertRepository) UpsertMovies(_ context.Context, movies []*ImportedMovie, editor string) (UpsertResult, error) {
+	r.calls++
+	if r.calls == r.failOn {
+		return UpsertResult{}, errors.New("connection lost")
+	}
+	if editor != importEditor {
+		return UpsertResult{}, errors.New("upserted by " + editor)
+	}
+
+	var result UpsertResult
+	for position, movie := range movies {
+		if slices.Contains(r.conflicts, movie.IMDbID) {
+			result.Conflicts = append(result.Conflicts, position)
+			continue
+		}
+		r.upserted = append(r.upserted, movie.IMDbID)
+		result.Inserted++
+	}
+	return result, nil
+}
+
+// importEventBus expects the given number of import completed events.
+func importEventBus(t *testing.T, events int) *shared.EventBus {
+	t.Helper()
+
+	producer := mocks.NewSyncProducer(t, nil)
+	for range events {
+		producer.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(message *sarama.ProducerMessage) error {
+			if message.Topic != MovieImportCompletedEventType {
+				return errors.New("published " + message.Topic)
+			}
+			return nil
+		})
+	}
+	t.Cleanup(func() { _ = producer.Close() })
+
+	eventBus := shared.NewEventBus(discardLogger)
+	eventBus.SyncProducer = producer
+	return eventBus
+}
+
+func TestImportResumesFromCheckpoint(t *testing.T) {
+	options := ImportOptions{
+		Format: ImportCSV,
+		Path: writeFixture(t, "movies.csv", "title,imdb_id\n"+
+			"Alien,tt0078748\n"+
+			"Heat,tt0113277\n"+
+			"No ID,\n"+
+			"Se7en,tt0114369\n"+
+			"Fargo,tt0116282\n"+
+			"Clash,tt0116282\n"+
+			"Ran,tt0089881\n"),
+		BatchSize: 2,
+		Progress:  filepath.Join(t.TempDir(), "import.progress"),
+		Report:    filepath.Join(t.TempDir(), "rejected.jsonl"),
+	}
+	repository := &upsertRepository{failOn: 2, conflicts: []string{"tt0116282"}}
+
+	// The second batch fails after the first was written
+	if _, err := NewImporter(repository, importEventBus(t, 0), discardLogger).Import(context.Background(), options); err == nil {
+		t.Fatal("the first run did not fail")
+	}
+	if _, err := os.Stat(options.Progress); err != nil {
+		t.Fatalf("no progress was saved: %v", err)
+	}
+
+	repository.failOn = 0
+	repository.conflicts = nil
+	summary, err := NewImporter(repository, importEventBus(t, 1), discardLogger).Import(context.Background(), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := ImportSummary{Read: 7, Inserted: 6, Rejected: 1}
+	if summary != want {
+		t.Errorf("got summary %+v, want %+v", summary, want)
+	}
+	wantUpserted := []string{"tt0078748", "tt0113277", "tt0114369", "tt0116282", "tt0116282", "tt0089881"}
+	if !slices.Equal(repository.upserted, wantUpserted) {
+		t.Errorf("upserted %v, want %v", repository.upserted, wantUpserted)
+	}
+	if _, err := os.Stat(options.Progress); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("the progress file was left behind: %v", err)
+	}
+
+	rejections := readRejections(t, options.Report)
+	if len(rejections) != 1 || rejections[0].Record != 3 {
+		t.Errorf("got rejections %+v, want record 3 once", rejections)
+	}
+}
+
+func TestImportReportsConflicts(t *testing.T) {
+	options := ImportOptions{
+		Format:    ImportCSV,
+		Path:      writeFixture(t, "movies.csv", "title,imdb_id\nAlien,tt0078748\nFargo,tt0116282\n"),
+		BatchSize: 10,
+		Report:    filepath.Join(t.TempDir(), "rejected.jsonl"),
+	}
+	repository := &upsertRepository{conflicts: []string{"tt0116282"}}
+
+	summary, err := NewImporter(repository, importEventBus(t, 1), discardLogger).Import(context.Background(), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := (ImportSummary{Read: 2, Inserted: 1, Rejected: 1}); summary != want {
+		t.Errorf("got summary %+v, want %+v", summary, want)
+	}
+	rejections := readRejections(t, options.Report)
+	if len(rejections) != 1 || rejections[0].Record != 2 || rejections[0].Key != "tt0116282" {
+		t.Errorf("got rejections %+v, want record 2", rejections)
+	}
+}
+
+func TestImportDryRun(t *testing.T) {
+	options := ImportOptions{
+		Format:    ImportJSONL,
+		Path:      writeFixture(t, "movies.jsonl", `{"title": "Alien", "imdb_id": "tt0078748"}`+"\n"+`{"title": ""}`+"\n"),
+		BatchSize: 10,
+		Progress:  filepath.Join(t.TempDir(), "import.progress"),
+		DryRun:    true,
+	}
+	repository := &upsertRepository{}
+
+	summary, err := NewImporter(repository, importEventBus(t, 0), discardLogger).Import(context.Background(), options)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := (ImportSummary{Read: 2, Rejected: 1}); summary != want {
+		t.Errorf("got summary %+v, want %+v", summary, want)
+	}
+	if repository.calls > 0 {
+		t.Error("a dry run wrote movies")
+	}
+	if _, err := os.Stat(options.Progress); !errors.Is(err, os.ErrNotExist) {
+		t.Error("a dry run saved progress")
+	}
+}
+
+func readRejections(t *testing.T, path string) []importRejection {
+	t.Helper()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	var rejections []importRejection
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var rejection importRejection
+		if err := json.Unmarshal(scanner.Bytes(), &rejection); err != nil {
+			t.Fatal(err)
+		}
+		rejections = append(rejections, rejection)
+	}
+	return rejections
+}
@@ -0,0 +1,317 @@
+package movies
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	schema "github.com/nameteos/my-movies-db-schema/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	MaxTranslations = 100
+	MaxReleases     = 250
+
+	// maxAcceptedLanguages bounds the languages of an Accept-Language value
+	// that are tried.
+	maxAcceptedLanguages = 10
+)
+
+var (
+	localePattern  = regexp.MustCompile(`^[a-z]{2,3}(-[A-Z]{2})?$`)
+	countryPattern = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
+var localizationsProjection = bson.M{fieldTranslations: 1, fieldReleases: 1}
+
+// Translation is the title and overview of a movie in one language.
+type Translation struct {
+	Title    string `json:"title" bson:"title" openapi:"required"`
+	Overview string `json:"overview,omitempty" bson:"overview,omitempty"`
+}
+
+// Release is when a movie came out in one country, with the age rating it
+// got there.
+type Release struct {
+	Country       string `json:"country" bson:"country" openapi:"required"`
+	Date          string `json:"date" bson:"date" openapi:"required"`
+	Certification string `json:"certification,omitempty" bson:"certification,omitempty"`
+}
+
+// Localizations are the translations of a movie by locale, such as "de" or
+// "pt-BR", and its releases by ISO 3166-1 country code. They are stored
+// alongside the fields of the schema.
+type Localizations struct {
+	Translations map[string]Translation `json:"translations" bson:"translations"`
+	Releases     []Release              `json:"releases" bson:"releases"`
+}
+
+// normalize trims the localizations and writes locales as language-REGION
+// and countries in upper case.
+func (l *Localizations) normalize() {
+	translations := make(map[string]Translation, len(l.Translations))
+	for locale, translation := range l.Translations {
+		translation.Title = strings.TrimSpace(translation.Title)
+		translation.Overview = strings.TrimSpace(translation.Overview)
+		translations[canonicalLocale(locale)] = translation
+	}
+	l.Translations = translations
+
+	if l.Releases == nil {
+		l.Releases = []Release{}
+	}
+	for i := range l.Releases {
+		release := &l.Releases[i]
+		release.Country = strings.ToUpper(strings.TrimSpace(release.Country))
+		release.Date = strings.TrimSpace(release.Date)
+		release.Certification = strings.TrimSpace(release.Certification)
+	}
+}
+
+func (l *Localizations) validate() map[string]string {
+	fields := make(map[string]string)
+
+	if len(l.Translations) > MaxTranslations {
+		fields["translations"] = fmt.Sprintf("must have at most %d translations", MaxTranslations)
+	}
+	for locale, translation := range l.Translations {
+		if !localePattern.MatchString(locale) {
+			fields["translations."+locale] = "must be a locale such as de or pt-BR"
+		} else if translation.Title == "" {
+			fields["translations."+locale+".title"] = "must not be empty"
+		}
+	}
+
+	if len(l.Releases) > MaxReleases {
+		fields["releases"] = fmt.Sprintf("must have at most %d releases", MaxReleases)
+		return fields
+	}
+	countries := make(map[string]bool, len(l.Releases))
+	for i, release := range l.Releases {
+		if !countryPattern.MatchString(release.Country) {
+			fields[fmt.Sprintf("releases[%d].country", i)] = "must be an ISO 3166-1 country code such as DE"
+		} else if countries[release.Country] {
+			fields[fmt.Sprintf("releases[%d].country", i)] = "repeats an earlier country"
+		}
+		countries[release.Country] = true
+		if _, err := time.Parse(time.DateOnly, release.Date); err != nil {
+			fields[fmt.Sprintf("releases[%d].date", i)] = "must be a date such as 1999-06-17"
+		}
+	}
+
+	return fields
+}
+
+// locales returns the locales translated to, in order.
+func (l *Localizations) locales() []string {
+	locales := make([]string, 0, len(l.Translations))
+	for locale := range l.Translations {
+		locales = append(locales, locale)
+	}
+	slices.Sort(locales)
+	return locales
+}
+
+// countries returns the countries with a release.
+func (l *Localizations) countries() []string {
+	countries := make([]string, 0, len(l.Releases))
+	for _, release := range l.Releases {
+		countries = append(countries, release.Country)
+	}
+	return countries
+}
+
+// release returns the release in the country, if there is one.
+func (l *Localizations) release(country string) (Release, bool) {
+	for _, release := range l.Releases {
+		if release.Country == country {
+			return release, true
+		}
+	}
+	return Release{}, false
+}
+
+// LocalizedMovie is a movie as read in the languages a client accepts.
+// Locale is the language the title and overview are in, empty when the
+// movie has no translation for any of them. Release is the release in the
+// region of the first accepted locale that has one.
+type LocalizedMovie struct {
+	*schema.Movie
+	Locale  string   `json:"locale,omitempty"`
+	Release *Release `json:"release,omitempty"`
+}
+
+// localize puts the title, overview and release date of the movie in the
+// first of the languages that has them. A language without a translation
+// that is the movie's original language takes the original title; without
+// either the catalog title and overview stay.
+func localize(movie *schema.Movie, localizations *Localizations, languages []string) *LocalizedMovie {
+	localized := &LocalizedMovie{Movie: movie}
+	if localizations == nil {
+		return localized
+	}
+
+	for _, locale := range languages {
+		language, _, _ := strings.Cut(locale, "-")
+		if translation, ok := localizations.Translations[locale]; ok {
+			localized.apply(locale, translation)
+		} else if translation, ok := localizations.Translations[language]; ok {
+			localized.apply(language, translation)
+		} else if language == movie.OriginalLanguage && movie.OriginalTitle != "" {
+			localized.apply(language, Translation{Title: movie.OriginalTitle})
+		} else {
+			continue
+		}
+		break
+	}
+
+	for _, locale := range languages {
+		_, country, ok := strings.Cut(locale, "-")
+		if !ok {
+			continue
+		}
+		if release, ok := localizations.release(country); ok {
+			movie.ReleaseDate = release.Date
+			localized.Release = &release
+			break
+		}
+	}
+
+	return localized
+}
+
+func (m *LocalizedMovie) apply(locale string, translation Translation) {
+	m.Locale = locale
+	m.Title = translation.Title
+	if translation.Overview != "" {
+		m.Overview = translation.Overview
+	}
+}
+
+// parseAcceptLanguage returns the locales of an Accept-Language value such as
+// "de-AT,de;q=0.9,en;q=0.5", most preferred first. Entries that are not
+// locales, like "*", and those with a quality of zero are left out.
+func parseAcceptLanguage(value string) []string {
+	type accepted struct {
+		locale  string
+		quality float64
+	}
+
+	var entries []accepted
+	for _, entry := range strings.Split(value, ",") {
+		tag, params, _ := strings.Cut(entry, ";")
+		locale := canonicalLocale(tag)
+		if !localePattern.MatchString(locale) {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		if quality <= 0 {
+			continue
+		}
+		entries = append(entries, accepted{locale, quality})
+	}
+	slices.SortStableFunc(entries, func(a, b accepted) int {
+		return cmp.Compare(b.quality, a.quality)
+	})
+
+	locales := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !slices.Contains(locales, entry.locale) && len(locales) < maxAcceptedLanguages {
+			locales = append(locales, entry.locale)
+		}
+	}
+	return locales
+}
+
+// canonicalLocale writes a locale as a lower case language and an upper case
+// region, accepting an underscore between them.
+func canonicalLocale(locale string) string {
+	language, region, found := strings.Cut(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"), "-")
+	if !found {
+		return strings.ToLower(language)
+	}
+	return strings.ToLower(language) + "-" + strings.ToUpper(region)
+}
+
+func (r *MongoRepository) GetLocalizations(ctx context.Context, id string) (*Localizations, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
+	}
+
+	var localizations Localizations
+	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}, options.FindOne().SetProjection(localizationsProjection)).Decode(&localizations)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrMovieNotFound
+		}
+		return nil, fmt.Errorf("failed to get localizations: %w", err)
+	}
+
+	return &localizations, nil
+}
+
+// FindLocalizations returns the localizations of the movies by ID, leaving
+// out those that do not exist.
+func (r *MongoRepository) FindLocalizations(ctx context.Context, ids []primitive.ObjectID) (map[primitive.ObjectID]*Localizations, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"_id": bson.M{"$in": ids}}, options.Find().SetProjection(localizationsProjection))
+	if err != nil {
+		return nil, fmt.Errorf("failed to find localizations: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var documents []struct {
+		ID            primitive.ObjectID `bson:"_id"`
+		Localizations `bson:",inline"`
+	}
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, fmt.Errorf("failed to decode localizations: %w", err)
+	}
+
+	localizations := make(map[primitive.ObjectID]*Localizations, len(documents))
+	for i := range documents {
+		localizations[documents[i].ID] = &documents[i].Localizations
+	}
+	return localizations, nil
+}
+
+// SetLocalizations replaces the translations and releases of a movie.
+func (r *MongoRepository) SetLocalizations(ctx context.Context, id string, localizations Localizations) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
+	}
+
+	update := bson.M{"$set": bson.M{
+		fieldTranslations: localizations.Translations,
+		fieldReleases:     localizations.Releases,
+		fieldUpdatedAt:    time.Now(),
+	}}
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": objectID}, update)
+	if err != nil {
+		return fmt.Errorf("failed to set localizations: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return ErrMovieNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package movies
+
+import (
+	"slices"
+	"testing"
+
+	schema "github.com/nameteos/my-movies-db-schema/mongodb"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  []string
+	}{
+		{name: "empty", value: "", want: []string{}},
+		{name: "single", value: "de", want: []string{"de"}},
+		{name: "ordered by quality", value: "en;q=0.5,de-AT,de;q=0.9", want: []string{"de-AT", "de", "en"}},
+		{name: "equal qualities keep their order", value: "fr;q=0.8,it;q=0.8,es", want: []string{"es", "fr", "it"}},
+		{name: "zero quality", value: "de,en;q=0,fr;q=0.0", want: []string{"de"}},
+		{name: "malformed quality", value: "de;q=high,en", want: []string{"en"}},
+		{name: "wildcard", value: "*,de;q=0.5", want: []string{"de"}},
+		{name: "underscores and case", value: "PT_br, EN-us;q=0.5", want: []string{"pt-BR", "en-US"}},
+		{name: "duplicates keep the best quality", value: "en;q=0.2,de;q=0.5,en-gb,EN;q=0.9", want: []string{"en-GB", "en", "de"}},
+		{name: "not locales", value: "english,de-DEU,1234,x", want: []string{}},
+		{name: "spaces", value: " de-AT ; q=0.9 , en ", want: []string{"en", "de-AT"}},
+		{
+			name:  "bounded",
+			value: "aa,bb,cc,dd,ee,ff,gg,hh,ii,jj,kk,ll",
+			want:  []string{"aa", "bb", "cc", "dd", "ee", "ff", "gg", "hh", "ii", "jj"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseAcceptLanguage(tt.value); !slices.Equal(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCanonicalLocale(t *testing.T) {
+	tests := map[string]string{
+		"de":      "de",
+		"DE":      "de",
+		"pt_br":   "pt-BR",
+		"Pt-bR":   "pt-BR",
+		" en-us ": "en-US",
+	}
+
+	for locale, want := range tests {
+		if got := canonicalLocale(locale); got != want {
+			t.Errorf("canonicalLocale(%q) = %q, want %q", locale, got, want)
+		}
+	}
+}
+
+func TestLocalize(t *testing.T) {
+	localizations := &Localizations{
+		Translations: map[string]Translation{
+			"de":    {Title: "Das Leben der Anderen", Overview: "Ost-Berlin, 1984."},
+			"pt-BR": {Title: "A Vida dos Outros"},
+			"fr":    {Title: "La Vie des autres", Overview: "Berlin-Est, 1984."},
+		},
+		Releases: []Release{
+			{Country: "DE", Date: "2006-03-23", Certification: "12"},
+			{Country: "US", Date: "2007-02-09", Certification: "R"},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		languages   []string
+		title       string
+		overview    string
+		locale      string
+		releaseDate string
+	}{
+		{name: "no languages", title: "The Lives of Others", overview: "East Berlin, 1984.", releaseDate: "2006-05-18"},
+		{name: "exact locale", languages: []string{"pt-BR"}, title: "A Vida dos Outros", overview: "East Berlin, 1984.", locale: "pt-BR", releaseDate: "2006-05-18"},
+		{name: "language of a locale", languages: []string{"de-AT"}, title: "Das Leben der Anderen", overview: "Ost-Berlin, 1984.", locale: "de", releaseDate: "2006-05-18"},
+		{name: "region of a locale", languages: []string{"de-DE"}, title: "Das Leben der Anderen", overview: "Ost-Berlin, 1984.", locale: "de", releaseDate: "2006-03-23"},
+		{name: "later language", languages: []string{"it", "fr"}, title: "La Vie des autres", overview: "Berlin-Est, 1984.", locale: "fr", releaseDate: "2006-05-18"},
+		{name: "original language", languages: []string{"it", "xx-US"}, title: "Das Leben der Anderen (original)", overview: "East Berlin, 1984.", locale: "xx", releaseDate: "2007-02-09"},
+		{name: "no translation", languages: []string{"it-IT"}, title: "The Lives of Others", overview: "East Berlin, 1984.", releaseDate: "2006-05-18"},
+		{name: "release from a later locale", languages: []string{"fr", "en-US"}, title: "La Vie des autres", overview: "Berlin-Est, 1984.", locale: "fr", releaseDate: "2007-02-09"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			movie := &schema.Movie{
+				Title:            "The Lives of Others",
+				OriginalTitle:    "Das Leben der Anderen (original)",
+				OriginalLanguage: "xx",
+				Overview:         "East Berlin, 1984.",
+				ReleaseDate:      "2006-05-18",
+			}
+
+			localized := localize(movie, localizations, tt.languages)
+
+			if localized.Title != tt.title || localized.Overview != tt.overview {
+				t.Errorf("got %q, %q, want %q, %q", localized.Title, localized.Overview, tt.title, tt.overview)
+			}
+			if localized.Locale != tt.locale {
+				t.Errorf("got locale %q, want %q", localized.Locale, tt.locale)
+			}
+			if localized.ReleaseDate != tt.releaseDate {
+				t.Errorf("got release date %q, want %q", localized.ReleaseDate, tt.releaseDate)
+			}
+			if (localized.Release != nil) != (tt.releaseDate != "2006-05-18") {
+				t.Errorf("got release %+v", localized.Release)
+			}
+		})
+	}
+}
+
+func TestLocalizeWithoutLocalizations(t *testing.T) {
+	movie := &schema.Movie{Title: "The Lives of Others", OriginalTitle: "Das Leben der Anderen", OriginalLanguage: "de"}
+
+	localized := localize(movie, nil, []string{"de"})
+
+	if localized.Title != "The Lives of Others" || localized.Locale != "" {
+		t.Errorf("got %q in %q", localized.Title, localized.Locale)
+	}
+}
+
+func TestLocalizationsValidate(t *testing.T) {
+	localizations := Localizations{
+		Translations: map[string]Translation{
+			"DE_at":  {Title: " Das Leben der Anderen "},
+			"fr":     {Title: "  "},
+			"german": {Title: "Das Leben der Anderen"},
+		},
+		Releases: []Release{
+			{Country: "de", Date: "2006-03-23"},
+			{Country: "DE", Date: "2006"},
+			{Country: "DEU", Date: "2006-03-23"},
+		},
+	}
+
+	localizations.normalize()
+	fields := localizations.validate()
+
+	if _, ok := localizations.Translations["de-AT"]; !ok {
+		t.Errorf("got locales %v, want de-AT", localizations.locales())
+	}
+	want := map[string]string{
+		"translations.fr.title": "must not be empty",
+		"translations.german":   "must be a locale such as de or pt-BR",
+		"releases[1].country":   "repeats an earlier country",
+		"releases[1].date":      "must be a date such as 1999-06-17",
+		"releases[2].country":   "must be an ISO 3166-1 country code such as DE",
+	}
+	if len(fields) != len(want) {
+		t.Errorf("got problems %v, want %v", fields, want)
+	}
+	for field, problem := range want {
+		if fields[field] != problem {
+			t.Errorf("got %q for %s, want %q", fields[field], field, problem)
+		}
+	}
+}
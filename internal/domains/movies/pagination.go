@@ -0,0 +1,85 @@
+package movies
+
+import (
+	"time"
+
+	"event-driven-go/internal/shared"
+	schema "github.com/nameteos/my-movies-db-schema/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const fieldScore = "score"
+
+// sortField is one field of a keyset order. The last field of an order must
+// be unique, which _id always is.
+type sortField struct {
+	name       string
+	descending bool
+}
+
+var (
+	recentOrder    = []sortField{{fieldCreatedAt, true}, {"_id", true}}
+	releaseOrder   = []sortField{{fieldReleaseDate, true}, {fieldTitle, false}, {"_id", false}}
+	relevanceOrder = []sortField{{fieldScore, true}, {"_id", false}}
+)
+
+// movieCursor holds the sort key of a movie in any of the orders; the fields
+// an order does not use stay empty. Sort is set by FindMovies, whose cursors
+// only continue a list with the same sort.
+type movieCursor struct {
+	Sort        MovieSort          `json:"sort,omitempty"`
+	CreatedAt   time.Time          `json:"created_at"`
+	ReleaseDate string             `json:"release_date,omitempty"`
+	Title       string             `json:"title,omitempty"`
+	Runtime     int                `json:"runtime,omitempty"`
+	Score       float64            `json:"score,omitempty"`
+	ID          primitive.ObjectID `json:"id"`
+}
+
+func recentCursorOf(movie *schema.Movie) interface{} {
+	return movieCursor{CreatedAt: movie.CreatedAt, ID: movie.ID}
+}
+
+func releaseCursorOf(movie *schema.Movie) interface{} {
+	return movieCursor{ReleaseDate: movie.ReleaseDate, Title: movie.Title, ID: movie.ID}
+}
+
+// keysetSort sorts in the order's direction, or against it when paging backward.
+func keysetSort(order []sortField, direction shared.Direction) bson.D {
+	sort := make(bson.D, 0, len(order))
+	for _, field := range order {
+		value := 1
+		if field.descending != (direction == shared.Before) {
+			value = -1
+		}
+		sort = append(sort, bson.E{Key: field.name, Value: value})
+	}
+	return sort
+}
+
+// keysetFilter matches the documents beyond the cursor's key in the paging
+// direction: those with an equal prefix of the key and a later next field.
+// values are the key's values in the order's field order.
+func keysetFilter(order []sortField, direction shared.Direction, values ...interface{}) bson.M {
+	if direction == shared.FromStart {
+		return bson.M{}
+	}
+
+	alternatives := make(bson.A, 0, len(order))
+	for i, field := range order {
+		condition := bson.M{}
+		for j := 0; j < i; j++ {
+			condition[order[j].name] = values[j]
+		}
+
+		operator := "$gt"
+		if field.descending != (direction == shared.Before) {
+			operator = "$lt"
+		}
+		condition[field.name] = bson.M{operator: values[i]}
+		alternatives = append(alternatives, condition)
+	}
+
+	return bson.M{"$or": alternatives}
+}
@@ -0,0 +1,138 @@
+package movies
+
+import (
+	"reflect"
+	"testing"
+
+	"event-driven-go/internal/shared"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestKeysetSort(t *testing.T) {
+	tests := []struct {
+		name      string
+		direction shared.Direction
+		want      bson.D
+	}{
+		{
+			name: "from start",
+			want: bson.D{{Key: fieldReleaseDate, Value: -1}, {Key: fieldTitle, Value: 1}, {Key: "_id", Value: 1}},
+		},
+		{
+			name:      "after",
+			direction: shared.After,
+			want:      bson.D{{Key: fieldReleaseDate, Value: -1}, {Key: fieldTitle, Value: 1}, {Key: "_id", Value: 1}},
+		},
+		{
+			name:      "before",
+			direction: shared.Before,
+			want:      bson.D{{Key: fieldReleaseDate, Value: 1}, {Key: fieldTitle, Value: -1}, {Key: "_id", Value: -1}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := keysetSort(releaseOrder, tt.direction); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKeysetFilter(t *testing.T) {
+	tests := []struct {
+		name      string
+		direction shared.Direction
+		want      bson.M
+	}{
+		{name: "from start", want: bson.M{}},
+		{
+			name:      "after",
+			direction: shared.After,
+			want: bson.M{"$or": bson.A{
+				bson.M{fieldReleaseDate: bson.M{"$lt": "1979-05-25"}},
+				bson.M{fieldReleaseDate: "1979-05-25", fieldTitle: bson.M{"$gt": "Alien"}},
+				bson.M{fieldReleaseDate: "1979-05-25", fieldTitle: "Alien", "_id": bson.M{"$gt": "id"}},
+			}},
+		},
+		{
+			name:      "before",
+			direction: shared.Before,
+			want: bson.M{"$or": bson.A{
+				bson.M{fieldReleaseDate: bson.M{"$gt": "1979-05-25"}},
+				bson.M{fieldReleaseDate: "1979-05-25", fieldTitle: bson.M{"$lt": "Alien"}},
+				bson.M{fieldReleaseDate: "1979-05-25", fieldTitle: "Alien", "_id": bson.M{"$lt": "id"}},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := keysetFilter(releaseOrder, tt.direction, "1979-05-25", "Alien", "id")
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestKeysetFilterMatchesSortOrder checks that the filter of a cursor
+// matches exactly the documents the sort puts beyond it.
+func TestKeysetFilterMatchesSortOrder(t *testing.T) {
+	type key struct {
+		score float64
+		id    string
+	}
+	keys := []key{{0.9, "a"}, {0.9, "b"}, {0.5, "a"}, {0.5, "c"}, {0.1, "b"}}
+	cursor := keys[1]
+
+	for _, direction := range []shared.Direction{shared.After, shared.Before} {
+		filter := keysetFilter(relevanceOrder, direction, cursor.score, cursor.id)
+		for position, document := range keys {
+			beyond := position > 1
+			if direction == shared.Before {
+				beyond = position < 1
+			}
+			if got := matches(filter, bson.M{fieldScore: document.score, "_id": document.id}); got != beyond {
+				t.Errorf("direction %d: document %v matched %t, want %t", direction, document, got, beyond)
+			}
+		}
+	}
+}
+
+// matches evaluates the $or of equalities, $lt and $gt keysetFilter builds.
+func matches(filter bson.M, document bson.M) bool {
+	for _, alternative := range filter["$or"].(bson.A) {
+		all := true
+		for field, condition := range alternative.(bson.M) {
+			if !compare(document[field], condition) {
+				all = false
+			}
+		}
+		if all {
+			return true
+		}
+	}
+	return false
+}
+
+func compare(value, condition interface{}) bool {
+	less := func(a, b interface{}) bool {
+		switch a := a.(type) {
+		case float64:
+			return a < b.(float64)
+		case string:
+			return a < b.(string)
+		}
+		panic("unsupported type")
+	}
+
+	operators, ok := condition.(bson.M)
+	if !ok {
+		return value == condition
+	}
+	if bound, ok := operators["$lt"]; ok {
+		return less(value, bound)
+	}
+	return less(operators["$gt"], value)
+}
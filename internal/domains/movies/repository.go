@@ -2,10 +2,14 @@ package movies
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	schema "github.com/nameteos/my-movies-db-schema/mongodb"
+	"log/slog"
+	"sync/atomic"
 	"time"
 
+	"event-driven-go/internal/shared"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -15,31 +19,90 @@ import (
 type Repository interface {
 	CreateMovie(ctx context.Context, movie *schema.Movie) (*schema.Movie, error)
 	GetMovieByID(ctx context.Context, id string) (*schema.Movie, error)
-	UpdateMovie(ctx context.Context, movie *schema.Movie) (*schema.Movie, error)
+	GetMoviesByIDs(ctx context.Context, ids []string) ([]*schema.Movie, error)
+	UpdateMovie(ctx context.Context, movie *schema.Movie, revision *Revision) (*schema.Movie, error)
 	DeleteMovie(ctx context.Context, id string) error
-	SearchMovies(ctx context.Context, query string, limit, offset int) ([]*schema.Movie, error)
-	GetMoviesByGenre(ctx context.Context, genre string, limit, offset int) ([]*schema.Movie, error)
-	GetMoviesByYear(ctx context.Context, year int, limit, offset int) ([]*schema.Movie, error)
-	GetMoviesByDirector(ctx context.Context, director string, limit, offset int) ([]*schema.Movie, error)
-	GetRecentMovies(ctx context.Context, limit, offset int) ([]*schema.Movie, error)
+	SearchMovies(ctx context.Context, matches []TextMatch, page shared.PageRequest) (*shared.Page[*schema.Movie], error)
+	GetMoviesByGenre(ctx context.Context, genre string, page shared.PageRequest) (*shared.Page[*schema.Movie], error)
+	GetMoviesByYear(ctx context.Context, year int, page shared.PageRequest) (*shared.Page[*schema.Movie], error)
+	GetMoviesByDirector(ctx context.Context, director string, page shared.PageRequest) (*shared.Page[*schema.Movie], error)
+	GetRecentMovies(ctx context.Context, page shared.PageRequest) (*shared.Page[*schema.Movie], error)
+	FindMovies(ctx context.Context, query MovieQuery) (*MovieSearchResult, error)
+	UpsertMovies(ctx context.Context, movies []*ImportedMovie, editor string) (UpsertResult, error)
+	GetMovieByExternalID(ctx context.Context, source ExternalSource, id string) (*schema.Movie, error)
+	GetExternalIDs(ctx context.Context, id string) (*ExternalIDs, error)
+	SetExternalIDs(ctx context.Context, id string, ids ExternalIDs, revision *Revision) (*schema.Movie, error)
+	GetLocalizations(ctx context.Context, id string) (*Localizations, error)
+	FindLocalizations(ctx context.Context, ids []primitive.ObjectID) (map[primitive.ObjectID]*Localizations, error)
+	SetLocalizations(ctx context.Context, id string, localizations Localizations) error
+	MergeMovies(ctx context.Context, survivorID string, duplicateIDs []string, ids ExternalIDs, revision *Revision) (*schema.Movie, error)
+	ScanFingerprints(ctx context.Context, fn func(*MovieFingerprint) error) error
+	GetSearchDocument(ctx context.Context, id string) (*SearchDocument, error)
+	ScanSearchDocuments(ctx context.Context, fn func(*SearchDocument) error) error
+	Autocomplete(ctx context.Context, prefix string, limit int) ([]*Suggestion, error)
+	RefreshSuggestion(ctx context.Context, id string) error
+	RebuildSuggestions(ctx context.Context) (int, error)
+	MergeSuggestions(ctx context.Context, survivorID string, duplicateIDs []string) error
+	AddPopularity(ctx context.Context, movieID string, delta int) error
+	GetEmbedding(ctx context.Context, id string, model string) ([]float32, error)
+	SetEmbedding(ctx context.Context, id string, embedding schema.EmbeddingObject) error
+	GetMoviesWithoutEmbedding(ctx context.Context, model string, limit int) ([]*schema.Movie, error)
+	NearestMovies(ctx context.Context, model string, vector []float32, k int, excludeID string) ([]*MovieMatch, error)
+	CreateCollection(ctx context.Context, collection *Collection) (*Collection, error)
+	GetCollectionByID(ctx context.Context, id string) (*Collection, error)
+	ListCollections(ctx context.Context, page shared.PageRequest) (*shared.Page[*Collection], error)
+	GetMovieCollections(ctx context.Context, movieID string) ([]*Collection, error)
+	UpdateCollection(ctx context.Context, collection *Collection) (*Collection, error)
+	DeleteCollection(ctx context.Context, id string) error
+	RemoveFromCollections(ctx context.Context, movieID string) error
+	MergeCollectionMembers(ctx context.Context, survivorID string, duplicateIDs []string) error
+	ListRevisions(ctx context.Context, movieID string, page shared.PageRequest) (*shared.Page[*Revision], error)
+	GetRevision(ctx context.Context, movieID, revisionID string) (*Revision, error)
+	GetRevisionsSince(ctx context.Context, revision *Revision) ([]*Revision, error)
+	DeleteRevisions(ctx context.Context, movieID string) error
+}
+
+// UpsertResult counts the movies an upsert created and the stored ones it
+// matched. Conflicts are the positions of the movies that were not written
+// because their IDs belong to different stored movies.
+type UpsertResult struct {
+	Inserted  int
+	Updated   int
+	Conflicts []int
 }
 
 type MongoRepository struct {
-	collection *mongo.Collection
-	indexer    *schema.MongoIndexer
+	collection  *mongo.Collection
+	suggestions *mongo.Collection
+	collections *mongo.Collection
+	revisions   *mongo.Collection
+	indexer     *schema.MongoIndexer
+	logger      *slog.Logger
+
+	// scanningEmbeddings is set once vector search has been found
+	// unavailable, to warn about it only once.
+	scanningEmbeddings atomic.Bool
 }
 
 func NewMongoIndexer(db *mongo.Database) *schema.MongoIndexer {
 	return &schema.MongoIndexer{
 		Collection:       db.Collection("movies"),
-		VectorDimensions: 1536,
+		VectorDimensions: EmbeddingDimensions,
 	}
 }
 
-func NewMongoRepository(db *mongo.Database) *MongoRepository {
+func NewMongoRepository(db *mongo.Database, logger *slog.Logger) *MongoRepository {
+	// Revisions hold values as JSON writes them, so documents among them are
+	// read back as maps rather than bson.D
+	revisionOptions := options.Collection().SetBSONOptions(&options.BSONOptions{DefaultDocumentM: true})
+
 	return &MongoRepository{
-		collection: db.Collection("movies"),
-		indexer:    NewMongoIndexer(db),
+		collection:  db.Collection("movies"),
+		suggestions: db.Collection("movie_suggestions"),
+		collections: db.Collection("movie_collections"),
+		revisions:   db.Collection("movie_revisions", revisionOptions),
+		indexer:     NewMongoIndexer(db),
+		logger:      logger.With(slog.String("repository", "movies")),
 	}
 }
 
@@ -52,9 +115,13 @@ func (r *MongoRepository) CreateMovie(ctx context.Context, movie *schema.Movie)
 	}
 
 	_, err := r.collection.InsertOne(ctx, movie)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, ErrDuplicateExternalID
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to create movie: %w", err)
 	}
+	r.logger.DebugContext(ctx, "movie created", slog.String("movie_id", movie.ID.Hex()))
 
 	return movie, nil
 }
@@ -62,14 +129,14 @@ func (r *MongoRepository) CreateMovie(ctx context.Context, movie *schema.Movie)
 func (r *MongoRepository) GetMovieByID(ctx context.Context, id string) (*schema.Movie, error) {
 	objectID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return nil, fmt.Errorf("invalid movie ID: %w", err)
+		return nil, fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
 	}
 
 	var movie schema.Movie
-	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&movie)
+	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}, options.FindOne().SetProjection(movieProjection)).Decode(&movie)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
-			return nil, fmt.Errorf("movie not found")
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrMovieNotFound
 		}
 		return nil, fmt.Errorf("failed to get movie: %w", err)
 	}
@@ -77,7 +144,37 @@ func (r *MongoRepository) GetMovieByID(ctx context.Context, id string) (*schema.
 	return &movie, nil
 }
 
-func (r *MongoRepository) UpdateMovie(ctx context.Context, movie *schema.Movie) (*schema.Movie, error) {
+// GetMoviesByIDs fetches the movies with the given IDs in a single $in query.
+// Movies that do not exist are left out, the result is in no particular order.
+func (r *MongoRepository) GetMoviesByIDs(ctx context.Context, ids []string) ([]*schema.Movie, error) {
+	objectIDs := make([]primitive.ObjectID, 0, len(ids))
+	for _, id := range ids {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
+		}
+		objectIDs = append(objectIDs, objectID)
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"_id": bson.M{"$in": objectIDs}}, options.Find().SetProjection(movieProjection))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get movies: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var movies []*schema.Movie
+	if err := cursor.All(ctx, &movies); err != nil {
+		return nil, fmt.Errorf("failed to decode movies: %w", err)
+	}
+
+	return movies, nil
+}
+
+// UpdateMovie replaces the catalog fields of a movie and records what
+// changed in the revision, which the editor is set on. The revision is
+// stored after the movie; should that fail, the update still stands and
+// the revision keeps the changes for the event.
+func (r *MongoRepository) UpdateMovie(ctx context.Context, movie *schema.Movie, revision *Revision) (*schema.Movie, error) {
 	movie.UpdatedAt = time.Now()
 
 	filter := bson.M{"_id": movie.ID}
@@ -87,24 +184,30 @@ func (r *MongoRepository) UpdateMovie(ctx context.Context, movie *schema.Movie)
 		ctx,
 		filter,
 		update,
-		options.FindOneAndUpdate().SetReturnDocument(options.After),
+		options.FindOneAndUpdate().SetReturnDocument(options.Before).SetProjection(movieProjection),
 	)
 
-	var updatedMovie schema.Movie
-	if err := result.Decode(&updatedMovie); err != nil {
-		if err == mongo.ErrNoDocuments {
-			return nil, fmt.Errorf("movie not found")
+	var previous schema.Movie
+	if err := result.Decode(&previous); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrMovieNotFound
+		}
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, ErrDuplicateExternalID
 		}
 		return nil, fmt.Errorf("failed to update movie: %w", err)
 	}
 
-	return &updatedMovie, nil
+	// Setting the whole movie leaves it as given
+	r.recordRevision(ctx, &previous, movie, revision)
+
+	return movie, nil
 }
 
 func (r *MongoRepository) DeleteMovie(ctx context.Context, id string) error {
 	objectID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return fmt.Errorf("invalid movie ID: %w", err)
+		return fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
 	}
 
 	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": objectID})
@@ -113,74 +216,144 @@ func (r *MongoRepository) DeleteMovie(ctx context.Context, id string) error {
 	}
 
 	if result.DeletedCount == 0 {
-		return fmt.Errorf("movie not found")
+		return ErrMovieNotFound
 	}
 
 	return nil
 }
 
-func (r *MongoRepository) SearchMovies(ctx context.Context, query string, limit, offset int) ([]*schema.Movie, error) {
-	// Create text search filter
-	filter := bson.M{
-		"$text": bson.M{
-			"$search": query,
-		},
+// SearchMovies pages through the matches of a search of the search index,
+// best matches first. The scores have to be added in an aggregation to page
+// by them.
+func (r *MongoRepository) SearchMovies(ctx context.Context, matches []TextMatch, page shared.PageRequest) (*shared.Page[*schema.Movie], error) {
+	var key movieCursor
+	direction, err := page.Seek(&key)
+	if err != nil {
+		return nil, err
 	}
 
-	// Set up options for pagination and sorting by text score
-	opts := options.Find().
-		SetLimit(int64(limit)).
-		SetSkip(int64(offset)).
-		SetSort(bson.M{"score": bson.M{"$meta": "textScore"}})
+	ids, scores := splitMatches(matches)
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"_id": bson.M{"$in": ids}}}},
+		{{Key: "$addFields", Value: matchScore(ids, scores)}},
+		{{Key: "$match", Value: keysetFilter(relevanceOrder, direction, key.Score, key.ID)}},
+		{{Key: "$sort", Value: keysetSort(relevanceOrder, direction)}},
+		{{Key: "$project", Value: movieProjection}},
+	}
+	if direction == shared.FromStart {
+		pipeline = append(pipeline, bson.D{{Key: "$skip", Value: int64(page.Offset)}})
+	}
+	pipeline = append(pipeline, bson.D{{Key: "$limit", Value: int64(page.Limit + 1)}})
 
-	cursor, err := r.collection.Find(ctx, filter, opts)
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
 	if err != nil {
 		return nil, fmt.Errorf("failed to search movies: %w", err)
 	}
 	defer cursor.Close(ctx)
 
-	var movies []*schema.Movie
-	for cursor.Next(ctx) {
-		var movie schema.Movie
-		if err := cursor.Decode(&movie); err != nil {
-			return nil, fmt.Errorf("failed to decode movie: %w", err)
-		}
-		movies = append(movies, &movie)
+	var results []*scoredMovie
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, fmt.Errorf("failed to decode movies: %w", err)
 	}
 
-	if err := cursor.Err(); err != nil {
-		return nil, fmt.Errorf("cursor error: %w", err)
+	scored := shared.NewPage(results, page, direction, func(result *scoredMovie) interface{} {
+		return movieCursor{Score: result.Score, ID: result.ID}
+	})
+	movies := make([]*schema.Movie, 0, len(scored.Items))
+	for _, result := range scored.Items {
+		movies = append(movies, &result.Movie)
 	}
 
-	return movies, nil
+	return &shared.Page[*schema.Movie]{Items: movies, NextCursor: scored.NextCursor, PrevCursor: scored.PrevCursor}, nil
 }
 
-// todo use cursor
-func (r *MongoRepository) GetMoviesByGenre(ctx context.Context, genre string, limit, offset int) ([]*schema.Movie, error) {
-	filter := bson.M{"genre": genre}
-	return r.findMoviesWithFilter(ctx, filter, limit, offset)
+// scoredMovie is a search result with its text score.
+type scoredMovie struct {
+	schema.Movie `bson:",inline"`
+	Score        float64 `bson:"score"`
 }
 
-func (r *MongoRepository) GetMoviesByYear(ctx context.Context, year int, limit, offset int) ([]*schema.Movie, error) {
-	filter := bson.M{"year": year}
-	return r.findMoviesWithFilter(ctx, filter, limit, offset)
+func splitMatches(matches []TextMatch) (bson.A, bson.A) {
+	ids := make(bson.A, 0, len(matches))
+	scores := make(bson.A, 0, len(matches))
+	for _, match := range matches {
+		ids = append(ids, match.ID)
+		scores = append(scores, match.Score)
+	}
+	return ids, scores
 }
 
-func (r *MongoRepository) GetMoviesByDirector(ctx context.Context, director string, limit, offset int) ([]*schema.Movie, error) {
-	filter := bson.M{"director": director}
-	return r.findMoviesWithFilter(ctx, filter, limit, offset)
+// matchScore sets the score of each movie to that of its match.
+func matchScore(ids, scores bson.A) bson.M {
+	return bson.M{fieldScore: bson.M{"$arrayElemAt": bson.A{scores, bson.M{"$indexOfArray": bson.A{ids, "$_id"}}}}}
 }
 
-func (r *MongoRepository) GetRecentMovies(ctx context.Context, limit, offset int) ([]*schema.Movie, error) {
-	opts := options.Find().
-		SetLimit(int64(limit)).
-		SetSkip(int64(offset)).
-		SetSort(bson.M{"created_at": -1})
+func (r *MongoRepository) GetMoviesByGenre(ctx context.Context, genre string, page shared.PageRequest) (*shared.Page[*schema.Movie], error) {
+	filter := bson.M{fieldGenreName: genre}
+	return r.findMoviesWithFilter(ctx, filter, page)
+}
+
+func (r *MongoRepository) GetMoviesByYear(ctx context.Context, year int, page shared.PageRequest) (*shared.Page[*schema.Movie], error) {
+	// Release dates are stored as YYYY-MM-DD strings
+	filter := bson.M{fieldReleaseDate: bson.M{"$regex": fmt.Sprintf("^%04d-", year)}}
+	return r.findMoviesWithFilter(ctx, filter, page)
+}
+
+func (r *MongoRepository) GetMoviesByDirector(ctx context.Context, director string, page shared.PageRequest) (*shared.Page[*schema.Movie], error) {
+	filter := bson.M{fieldDirector: director}
+	return r.findMoviesWithFilter(ctx, filter, page)
+}
+
+func (r *MongoRepository) GetRecentMovies(ctx context.Context, page shared.PageRequest) (*shared.Page[*schema.Movie], error) {
+	var key movieCursor
+	direction, err := page.Seek(&key)
+	if err != nil {
+		return nil, err
+	}
 
-	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	filter := keysetFilter(recentOrder, direction, key.CreatedAt, key.ID)
+	movies, err := r.findMovies(ctx, filter, page, direction, recentOrder)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get recent movies: %w", err)
 	}
+
+	return shared.NewPage(movies, page, direction, recentCursorOf), nil
+}
+
+// findMoviesWithFilter pages through the matching movies, newest release first.
+func (r *MongoRepository) findMoviesWithFilter(ctx context.Context, filter bson.M, page shared.PageRequest) (*shared.Page[*schema.Movie], error) {
+	var key movieCursor
+	direction, err := page.Seek(&key)
+	if err != nil {
+		return nil, err
+	}
+
+	if direction != shared.FromStart {
+		filter = bson.M{"$and": bson.A{filter, keysetFilter(releaseOrder, direction, key.ReleaseDate, key.Title, key.ID)}}
+	}
+	movies, err := r.findMovies(ctx, filter, page, direction, releaseOrder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find movies: %w", err)
+	}
+
+	return shared.NewPage(movies, page, direction, releaseCursorOf), nil
+}
+
+// findMovies reads the rows shared.NewPage expects: one more than the page
+// size, in the order of the paging direction.
+func (r *MongoRepository) findMovies(ctx context.Context, filter bson.M, page shared.PageRequest, direction shared.Direction, order []sortField) ([]*schema.Movie, error) {
+	opts := options.Find().
+		SetLimit(int64(page.Limit + 1)).
+		SetSort(keysetSort(order, direction)).
+		SetProjection(movieProjection)
+	if direction == shared.FromStart {
+		opts.SetSkip(int64(page.Offset))
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
 	defer cursor.Close(ctx)
 
 	var movies []*schema.Movie
@@ -199,37 +372,327 @@ func (r *MongoRepository) GetRecentMovies(ctx context.Context, limit, offset int
 	return movies, nil
 }
 
-func (r *MongoRepository) findMoviesWithFilter(ctx context.Context, filter bson.M, limit, offset int) ([]*schema.Movie, error) {
-	opts := options.Find().
-		SetLimit(int64(limit)).
-		SetSkip(int64(offset)).
-		SetSort(bson.M{"year": -1, "title": 1})
+// UpsertMovies writes the movies one by one, matching stored movies by
+// their external or IMDb ID. The changes to each stored movie are recorded
+// as a revision by the editor.
+func (r *MongoRepository) UpsertMovies(ctx context.Context, movies []*ImportedMovie, editor string) (UpsertResult, error) {
+	var result UpsertResult
+	now := time.Now()
+	for position, movie := range movies {
+		filter, update := movie.upsert(now)
+		_, err := r.updateRevised(ctx, filter, update, &Revision{Editor: editor}, options.FindOneAndUpdate().SetUpsert(true))
+		switch {
+		case err == nil:
+			result.Updated++
+		case errors.Is(err, mongo.ErrNoDocuments):
+			// Upserting returns no document from before a movie existed
+			result.Inserted++
+		case mongo.IsDuplicateKeyError(err):
+			result.Conflicts = append(result.Conflicts, position)
+		default:
+			return result, fmt.Errorf("failed to upsert movies: %w", err)
+		}
+	}
+	r.logger.DebugContext(ctx, "movies upserted",
+		slog.Int("inserted", result.Inserted),
+		slog.Int("matched", result.Updated),
+		slog.Int("conflicts", len(result.Conflicts)),
+	)
 
-	cursor, err := r.collection.Find(ctx, filter, opts)
+	return result, nil
+}
+
+func (r *MongoRepository) GetMovieByExternalID(ctx context.Context, source ExternalSource, id string) (*schema.Movie, error) {
+	key, value, err := parseExternalID(source, id)
 	if err != nil {
-		return nil, fmt.Errorf("failed to find movies: %w", err)
+		return nil, err
+	}
+
+	var movie schema.Movie
+	err = r.collection.FindOne(ctx, bson.M{key: value}, options.FindOne().SetProjection(movieProjection)).Decode(&movie)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrMovieNotFound
+		}
+		return nil, fmt.Errorf("failed to get movie by external ID: %w", err)
+	}
+
+	return &movie, nil
+}
+
+func (r *MongoRepository) GetExternalIDs(ctx context.Context, id string) (*ExternalIDs, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
+	}
+
+	var ids ExternalIDs
+	projection := bson.M{fieldIMDbID: 1, fieldExternalID: 1, fieldWikidataID: 1}
+	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}, options.FindOne().SetProjection(projection)).Decode(&ids)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrMovieNotFound
+		}
+		return nil, fmt.Errorf("failed to get external IDs: %w", err)
+	}
+
+	return &ids, nil
+}
+
+// SetExternalIDs replaces the external IDs of a movie and records the
+// change in the revision; empty IDs are cleared.
+func (r *MongoRepository) SetExternalIDs(ctx context.Context, id string, ids ExternalIDs, revision *Revision) (*schema.Movie, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
+	}
+
+	movie, err := r.updateRevised(ctx, bson.M{"_id": objectID}, externalIDsUpdate(ids), revision, options.FindOneAndUpdate())
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrMovieNotFound
+	}
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, ErrDuplicateExternalID
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to set external IDs: %w", err)
+	}
+
+	return movie, nil
+}
+
+// externalIDsUpdate writes the IDs the way the schema stores them: the IMDb
+// and TMDb IDs are always present, empty or zero when unknown, while the
+// Wikidata ID is left out.
+func externalIDsUpdate(ids ExternalIDs) bson.M {
+	set := bson.M{fieldIMDbID: ids.IMDb, fieldExternalID: ids.TMDb, fieldUpdatedAt: time.Now()}
+	if ids.Wikidata == "" {
+		return bson.M{"$set": set, "$unset": bson.M{fieldWikidataID: ""}}
+	}
+	set[fieldWikidataID] = ids.Wikidata
+	return bson.M{"$set": set}
+}
+
+// MergeMovies deletes the duplicates and gives the survivor the combined
+// external IDs, recording them in the revision. The duplicates go first so
+// that their IDs are free.
+func (r *MongoRepository) MergeMovies(ctx context.Context, survivorID string, duplicateIDs []string, ids ExternalIDs, revision *Revision) (*schema.Movie, error) {
+	survivor, err := primitive.ObjectIDFromHex(survivorID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
+	}
+	duplicates := make([]primitive.ObjectID, 0, len(duplicateIDs))
+	for _, id := range duplicateIDs {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
+		}
+		duplicates = append(duplicates, objectID)
+	}
+
+	if _, err := r.collection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": duplicates}}); err != nil {
+		return nil, fmt.Errorf("failed to delete merged movies: %w", err)
+	}
+	movie, err := r.updateRevised(ctx, bson.M{"_id": survivor}, externalIDsUpdate(ids), revision, options.FindOneAndUpdate())
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrMovieNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update merged movie: %w", err)
+	}
+	r.logger.DebugContext(ctx, "movies merged",
+		slog.String("movie_id", survivorID),
+		slog.Any("merged_ids", duplicateIDs),
+	)
+
+	return movie, nil
+}
+
+// ScanFingerprints calls fn with the fingerprint of every movie.
+func (r *MongoRepository) ScanFingerprints(ctx context.Context, fn func(*MovieFingerprint) error) error {
+	projection := bson.M{
+		fieldTitle: 1, fieldReleaseDate: 1, fieldRuntime: 1, fieldCreatedAt: 1,
+		fieldIMDbID: 1, fieldExternalID: 1, fieldWikidataID: 1,
+	}
+	cursor, err := r.collection.Find(ctx, bson.M{}, options.Find().SetProjection(projection).SetBatchSize(5000))
+	if err != nil {
+		return fmt.Errorf("failed to scan movies: %w", err)
 	}
 	defer cursor.Close(ctx)
 
-	var movies []*schema.Movie
 	for cursor.Next(ctx) {
-		var movie schema.Movie
-		if err := cursor.Decode(&movie); err != nil {
-			return nil, fmt.Errorf("failed to decode movie: %w", err)
+		var fingerprint MovieFingerprint
+		if err := cursor.Decode(&fingerprint); err != nil {
+			return fmt.Errorf("failed to decode movie: %w", err)
+		}
+		if err := fn(&fingerprint); err != nil {
+			return err
 		}
-		movies = append(movies, &movie)
 	}
 
-	if err := cursor.Err(); err != nil {
-		return nil, fmt.Errorf("cursor error: %w", err)
+	return cursor.Err()
+}
+
+var searchDocumentProjection = bson.M{
+	fieldTitle: 1, fieldOriginalTitle: 1, fieldOverview: 1, fieldCast: 1, fieldGenreName: 1, fieldTranslations: 1,
+}
+
+// GetSearchDocument returns what the search index holds of a movie.
+func (r *MongoRepository) GetSearchDocument(ctx context.Context, id string) (*SearchDocument, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
 	}
 
-	return movies, nil
+	var document SearchDocument
+	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}, options.FindOne().SetProjection(searchDocumentProjection)).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrMovieNotFound
+		}
+		return nil, fmt.Errorf("failed to get movie: %w", err)
+	}
+
+	return &document, nil
+}
+
+// ScanSearchDocuments calls fn with what the search index holds of each
+// movie in the catalog.
+func (r *MongoRepository) ScanSearchDocuments(ctx context.Context, fn func(*SearchDocument) error) error {
+	cursor, err := r.collection.Find(ctx, bson.M{}, options.Find().SetProjection(searchDocumentProjection).SetBatchSize(1000))
+	if err != nil {
+		return fmt.Errorf("failed to scan movies: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var document SearchDocument
+		if err := cursor.Decode(&document); err != nil {
+			return fmt.Errorf("failed to decode movie: %w", err)
+		}
+		if err := fn(&document); err != nil {
+			return err
+		}
+	}
+
+	return cursor.Err()
+}
+
+// catalogIndexes are the regular indexes of the schema's indexer, which it
+// does not create without Atlas Search.
+func catalogIndexes() []mongo.IndexModel {
+	return []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "title", Value: "text"},
+				{Key: "overview", Value: "text"},
+				{Key: "genres.name", Value: "text"},
+				{Key: "spoken_languages.name", Value: "text"},
+			},
+			Options: options.Index().SetName("movies_text_idx"),
+		},
+		{
+			Keys:    bson.D{{Key: "created_at", Value: -1}},
+			Options: options.Index().SetName("created_at_desc_idx"),
+		},
+		{
+			Keys:    bson.D{{Key: "release_date", Value: 1}},
+			Options: options.Index().SetName("release_date_idx"),
+		},
+	}
 }
 
 func (r *MongoRepository) CreateIndexes(ctx context.Context) error {
-	if _, err := r.indexer.CreateIndexes(ctx); err != nil {
-		return fmt.Errorf("failed to create indexes: %w", err)
+	indexes, err := r.indexer.CreateIndexes(ctx)
+	if err != nil {
+		// The indexer creates the vector index first, which needs Atlas
+		// Search, and stops before the regular indexes when it fails.
+		// Similarity search then compares embeddings one by one.
+		r.logger.WarnContext(ctx, "failed to create the vector search index", slog.Any("error", err))
+		if indexes, err = r.collection.Indexes().CreateMany(ctx, catalogIndexes()); err != nil {
+			return fmt.Errorf("failed to create indexes: %w", err)
+		}
+	}
+	r.logger.DebugContext(ctx, "movie indexes created", slog.Any("indexes", indexes))
+
+	// The keyset orders of the movie lists
+	pagingIndexes := []mongo.IndexModel{
+		{Keys: keysetSort(recentOrder, shared.After)},
+		{Keys: keysetSort(releaseOrder, shared.After)},
+	}
+	if _, err := r.collection.Indexes().CreateMany(ctx, pagingIndexes); err != nil {
+		return fmt.Errorf("failed to create paging indexes: %w", err)
 	}
+
+	// External IDs are unique among the movies that have them. A sparse index
+	// would not do, as the schema stores unknown IMDb and TMDb IDs as empty
+	// values rather than leaving them out.
+	idIndexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: fieldExternalID, Value: 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{fieldExternalID: bson.M{"$gt": 0}}),
+		},
+		{
+			Keys:    bson.D{{Key: fieldIMDbID, Value: 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{fieldIMDbID: bson.M{"$gt": ""}}),
+		},
+		{
+			Keys:    bson.D{{Key: fieldWikidataID, Value: 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{fieldWikidataID: bson.M{"$gt": ""}}),
+		},
+	}
+	for _, index := range idIndexes {
+		_, err := r.collection.Indexes().CreateOne(ctx, index)
+		if mongo.IsDuplicateKeyError(err) {
+			// Catalogs from before the indexes existed may hold duplicates,
+			// which the dedupe command merges
+			r.logger.WarnContext(ctx, "movies share external IDs, run the dedupe command to merge them",
+				slog.Any("index", index.Keys),
+				slog.Any("error", err),
+			)
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create external ID indexes: %w", err)
+		}
+	}
+
+	// Suggestions are looked up by prefix and ranked by popularity
+	suggestionIndex := mongo.IndexModel{
+		Keys: bson.D{{Key: "prefixes", Value: 1}, {Key: "popularity", Value: -1}, {Key: "title", Value: 1}},
+	}
+	if _, err := r.suggestions.Indexes().CreateOne(ctx, suggestionIndex); err != nil {
+		return fmt.Errorf("failed to create suggestion index: %w", err)
+	}
+
+	// Collections are listed by name and looked up by their members
+	collectionIndexes := []mongo.IndexModel{
+		{Keys: keysetSort(collectionOrder, shared.After)},
+		{Keys: bson.D{{Key: "movie_ids", Value: 1}}},
+	}
+	if _, err := r.collections.Indexes().CreateMany(ctx, collectionIndexes); err != nil {
+		return fmt.Errorf("failed to create collection indexes: %w", err)
+	}
+
+	// The revisions of a movie are listed newest first
+	revisionIndex := mongo.IndexModel{
+		Keys: append(bson.D{{Key: "movie_id", Value: 1}}, keysetSort(revisionOrder, shared.After)...),
+	}
+	if _, err := r.revisions.Indexes().CreateOne(ctx, revisionIndex); err != nil {
+		return fmt.Errorf("failed to create revision index: %w", err)
+	}
+
+	// The events keep the suggestions up to date, but a catalog from before
+	// they existed has to be indexed once
+	suggestions, err := r.suggestions.EstimatedDocumentCount(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to count suggestions: %w", err)
+	}
+	if suggestions == 0 {
+		if _, err := r.RebuildSuggestions(ctx); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
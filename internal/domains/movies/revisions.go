@@ -0,0 +1,315 @@
+package movies
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"time"
+
+	"event-driven-go/internal/shared"
+	schema "github.com/nameteos/my-movies-db-schema/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+var revisionOrder = []sortField{{"created_at", true}, {"_id", true}}
+
+// revisedFields are the catalog fields a revision tracks, the fields of
+// MovieRequest by their JSON names.
+var revisedFields = []string{
+	"title", "original_title", "original_language", "overview", "tagline", "status",
+	"release_date", "runtime", "adult", "budget", "revenue", "genres", "spoken_languages",
+	"poster_path", "imdb_id", "external_id",
+}
+
+// FieldChange is the value of a field before and after a revision, as it is
+// written in JSON.
+type FieldChange struct {
+	Field  string      `json:"field" bson:"field"`
+	Before interface{} `json:"before" bson:"before"`
+	After  interface{} `json:"after" bson:"after"`
+}
+
+// Revision is an update of the catalog fields of a movie. Editor is the ID of
+// the user who made it, or system: and the name of a maintenance command.
+type Revision struct {
+	ID      primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	MovieID primitive.ObjectID `json:"movie_id" bson:"movie_id"`
+	Editor  string             `json:"editor" bson:"editor"`
+	Changes []FieldChange      `json:"changes" bson:"changes"`
+	// RevertedTo is set on the revisions made by reverting to an earlier one.
+	RevertedTo *primitive.ObjectID `json:"reverted_to,omitempty" bson:"reverted_to,omitempty"`
+	CreatedAt  time.Time           `json:"created_at" bson:"created_at"`
+}
+
+// changedFields returns the names of the fields the revision changed.
+func (r *Revision) changedFields() []string {
+	fields := make([]string, 0, len(r.Changes))
+	for _, change := range r.Changes {
+		fields = append(fields, change.Field)
+	}
+	return fields
+}
+
+// revisionCursor is the sort key of a revision in revisionOrder.
+type revisionCursor struct {
+	CreatedAt time.Time          `json:"created_at"`
+	ID        primitive.ObjectID `json:"id"`
+}
+
+func revisionCursorOf(revision *Revision) interface{} {
+	return revisionCursor{CreatedAt: revision.CreatedAt, ID: revision.ID}
+}
+
+// revisedValues returns the tracked fields of the movie as JSON decodes them,
+// so that they compare equal however they were stored. Empty lists count as
+// missing.
+func revisedValues(movie *schema.Movie) (map[string]interface{}, error) {
+	data, err := json.Marshal(movie)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode movie: %w", err)
+	}
+	var all map[string]interface{}
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, fmt.Errorf("failed to decode movie: %w", err)
+	}
+
+	values := make(map[string]interface{}, len(revisedFields))
+	for _, field := range revisedFields {
+		value := all[field]
+		if list, ok := value.([]interface{}); ok && len(list) == 0 {
+			value = nil
+		}
+		values[field] = value
+	}
+	return values, nil
+}
+
+// diffMovies returns the changes to the tracked fields from before to after,
+// in the order of revisedFields.
+func diffMovies(before, after *schema.Movie) ([]FieldChange, error) {
+	old, err := revisedValues(before)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := revisedValues(after)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []FieldChange
+	for _, field := range revisedFields {
+		if !reflect.DeepEqual(old[field], updated[field]) {
+			changes = append(changes, FieldChange{Field: field, Before: old[field], After: updated[field]})
+		}
+	}
+	return changes, nil
+}
+
+// restoreValues sets the tracked fields of the movie to the values, which
+// may have been read back from a revision.
+func restoreValues(movie *schema.Movie, values map[string]interface{}) error {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return fmt.Errorf("failed to encode revised fields: %w", err)
+	}
+	var request MovieRequest
+	if err := json.Unmarshal(data, &request); err != nil {
+		return fmt.Errorf("failed to decode revised fields: %w", err)
+	}
+	if fields := request.validate(); len(fields) > 0 {
+		return shared.NewValidationError("the movie as of the revision is invalid", fields)
+	}
+
+	request.applyTo(movie)
+	return nil
+}
+
+// revertRevisions undoes the revisions, given newest first, on the movie.
+// Undoing the newest first leaves each field as the oldest of them found it.
+func revertRevisions(movie *schema.Movie, revisions []*Revision) error {
+	values, err := revisedValues(movie)
+	if err != nil {
+		return err
+	}
+	for _, revision := range revisions {
+		for _, change := range revision.Changes {
+			values[change.Field] = change.Before
+		}
+	}
+	return restoreValues(movie, values)
+}
+
+// recordRevision stores the changes from before to after as a revision,
+// filling it in. Nothing is stored when nothing changed. It is called once
+// the movie is written, so a revision that cannot be stored is only logged:
+// failing would hide a change that was made from the caller and its event.
+func (r *MongoRepository) recordRevision(ctx context.Context, before, after *schema.Movie, revision *Revision) {
+	revision.MovieID = after.ID
+	changes, err := diffMovies(before, after)
+	if err != nil {
+		r.logger.ErrorContext(ctx, "failed to compare movie revisions", slog.String("movie_id", after.ID.Hex()), slog.Any("error", err))
+		return
+	}
+	revision.Changes = changes
+	if len(changes) == 0 {
+		return
+	}
+
+	revision.ID = primitive.NewObjectID()
+	revision.CreatedAt = after.UpdatedAt
+	if _, err := r.revisions.InsertOne(ctx, revision); err != nil {
+		r.logger.ErrorContext(ctx, "failed to record revision",
+			slog.String("movie_id", after.ID.Hex()),
+			slog.Any("changed_fields", revision.changedFields()),
+			slog.Any("error", err),
+		)
+	}
+}
+
+// updateRevised applies an update of top-level fields to the movie matching
+// the filter and records what it changed in the revision. It returns the
+// movie as updated, or mongo.ErrNoDocuments when no movie matched, which
+// includes an upsert inserting one.
+func (r *MongoRepository) updateRevised(ctx context.Context, filter, update bson.M, revision *Revision, opts *options.FindOneAndUpdateOptions) (*schema.Movie, error) {
+	opts.SetReturnDocument(options.Before).SetProjection(movieProjection)
+
+	var previous schema.Movie
+	if err := r.collection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&previous); err != nil {
+		return nil, err
+	}
+
+	updated, err := applyUpdate(&previous, update)
+	if err != nil {
+		// The update is made, only its revision cannot be worked out
+		r.logger.ErrorContext(ctx, "failed to record revision", slog.String("movie_id", previous.ID.Hex()), slog.Any("error", err))
+		return &previous, nil
+	}
+	r.recordRevision(ctx, &previous, updated, revision)
+	return updated, nil
+}
+
+// applyUpdate returns the movie as the $set and $unset of the update leave
+// it. $setOnInsert is ignored, as it does not apply to a stored movie.
+func applyUpdate(movie *schema.Movie, update bson.M) (*schema.Movie, error) {
+	raw, err := bson.Marshal(movie)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode movie: %w", err)
+	}
+	var document bson.M
+	if err := bson.Unmarshal(raw, &document); err != nil {
+		return nil, fmt.Errorf("failed to decode movie: %w", err)
+	}
+
+	if set, ok := update["$set"].(bson.M); ok {
+		for key, value := range set {
+			document[key] = value
+		}
+	}
+	if unset, ok := update["$unset"].(bson.M); ok {
+		for key := range unset {
+			delete(document, key)
+		}
+	}
+
+	if raw, err = bson.Marshal(document); err != nil {
+		return nil, fmt.Errorf("failed to encode updated movie: %w", err)
+	}
+	var updated schema.Movie
+	if err := bson.Unmarshal(raw, &updated); err != nil {
+		return nil, fmt.Errorf("failed to decode updated movie: %w", err)
+	}
+	return &updated, nil
+}
+
+// ListRevisions pages through the revisions of a movie, newest first.
+func (r *MongoRepository) ListRevisions(ctx context.Context, movieID string, page shared.PageRequest) (*shared.Page[*Revision], error) {
+	objectID, err := primitive.ObjectIDFromHex(movieID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
+	}
+
+	var key revisionCursor
+	direction, err := page.Seek(&key)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := options.Find().
+		SetLimit(int64(page.Limit + 1)).
+		SetSort(keysetSort(revisionOrder, direction))
+	if direction == shared.FromStart {
+		opts.SetSkip(int64(page.Offset))
+	}
+	filter := keysetFilter(revisionOrder, direction, key.CreatedAt, key.ID)
+	filter["movie_id"] = objectID
+	revisions, err := r.findRevisions(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return shared.NewPage(revisions, page, direction, revisionCursorOf), nil
+}
+
+func (r *MongoRepository) GetRevision(ctx context.Context, movieID, revisionID string) (*Revision, error) {
+	movieObjectID, err := primitive.ObjectIDFromHex(movieID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
+	}
+	objectID, err := primitive.ObjectIDFromHex(revisionID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidRevisionID, err)
+	}
+
+	var revision Revision
+	err = r.revisions.FindOne(ctx, bson.M{"_id": objectID, "movie_id": movieObjectID}).Decode(&revision)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrRevisionNotFound
+		}
+		return nil, fmt.Errorf("failed to get revision: %w", err)
+	}
+
+	return &revision, nil
+}
+
+// GetRevisionsSince returns the revisions of the movie made after the
+// revision, newest first.
+func (r *MongoRepository) GetRevisionsSince(ctx context.Context, revision *Revision) ([]*Revision, error) {
+	filter := keysetFilter(revisionOrder, shared.Before, revision.CreatedAt, revision.ID)
+	filter["movie_id"] = revision.MovieID
+
+	return r.findRevisions(ctx, filter, options.Find().SetSort(keysetSort(revisionOrder, shared.After)))
+}
+
+func (r *MongoRepository) findRevisions(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*Revision, error) {
+	cursor, err := r.revisions.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find revisions: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	revisions := []*Revision{}
+	if err := cursor.All(ctx, &revisions); err != nil {
+		return nil, fmt.Errorf("failed to decode revisions: %w", err)
+	}
+	return revisions, nil
+}
+
+// DeleteRevisions removes the history of a deleted movie.
+func (r *MongoRepository) DeleteRevisions(ctx context.Context, movieID string) error {
+	objectID, err := primitive.ObjectIDFromHex(movieID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
+	}
+
+	if _, err := r.revisions.DeleteMany(ctx, bson.M{"movie_id": objectID}); err != nil {
+		return fmt.Errorf("failed to delete revisions: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package movies
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+	"testing"
+
+	"event-driven-go/internal/shared"
+	schema "github.com/nameteos/my-movies-db-schema/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+)
+
+func baseMovie() schema.Movie {
+	return schema.Movie{
+		Title:       "Alien",
+		ReleaseDate: "1979-05-25",
+		Runtime:     117,
+		Genres:      []schema.TMDBGenre{{ID: 27, Name: "Horror"}, {ID: 878, Name: "Science Fiction"}},
+		IMDbID:      "tt0078748",
+	}
+}
+
+func TestDiffMovies(t *testing.T) {
+	tests := []struct {
+		name   string
+		edit   func(*schema.Movie)
+		fields []string
+	}{
+		{name: "no change", edit: func(*schema.Movie) {}},
+		{name: "empty list for no list", edit: func(m *schema.Movie) { m.SpokenLanguages = []schema.TMDBLanguage{} }},
+		{name: "untracked field", edit: func(m *schema.Movie) { m.Embeddings = map[string]schema.EmbeddingObject{"x": {}} }},
+		{name: "one field", edit: func(m *schema.Movie) { m.Runtime = 116 }, fields: []string{"runtime"}},
+		{
+			name: "in tracked order",
+			edit: func(m *schema.Movie) {
+				m.ExternalID = 348
+				m.Title = "Alien: Director's Cut"
+				m.Genres = m.Genres[:1]
+			},
+			fields: []string{"title", "genres", "external_id"},
+		},
+		{name: "list emptied", edit: func(m *schema.Movie) { m.Genres = nil }, fields: []string{"genres"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before := baseMovie()
+			after := baseMovie()
+			tt.edit(&after)
+
+			changes, err := diffMovies(&before, &after)
+			if err != nil {
+				t.Fatal(err)
+			}
+			fields := (&Revision{Changes: changes}).changedFields()
+			if !slices.Equal(fields, tt.fields) {
+				t.Errorf("changed %v, want %v", fields, tt.fields)
+			}
+		})
+	}
+}
+
+func TestDiffMoviesValues(t *testing.T) {
+	before := baseMovie()
+	after := baseMovie()
+	after.Title = "Aliens"
+	after.Genres = nil
+
+	changes, err := diffMovies(&before, &after)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []FieldChange{
+		{Field: "title", Before: "Alien", After: "Aliens"},
+		{Field: "genres", Before: []interface{}{
+			map[string]interface{}{"id": float64(27), "name": "Horror"},
+			map[string]interface{}{"id": float64(878), "name": "Science Fiction"},
+		}, After: nil},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("got %#v, want %#v", changes, want)
+	}
+}
+
+// history applies the edits to the base movie one after the other, returning
+// the movie after each and the revisions, newest first, as they are read
+// back from the database.
+func history(t *testing.T, edits ...func(*schema.Movie)) ([]schema.Movie, []*Revision) {
+	t.Helper()
+
+	states := []schema.Movie{baseMovie()}
+	var revisions []*Revision
+	for _, edit := range edits {
+		before := states[len(states)-1]
+		after := before
+		after.Genres = slices.Clone(before.Genres)
+		edit(&after)
+
+		changes, err := diffMovies(&before, &after)
+		if err != nil {
+			t.Fatal(err)
+		}
+		revisions = slices.Insert(revisions, 0, storedRevision(t, &Revision{Changes: changes}))
+		states = append(states, after)
+	}
+	return states, revisions
+}
+
+// storedRevision round-trips the revision through BSON the way the revision
+// collection decodes it.
+func storedRevision(t *testing.T, revision *Revision) *Revision {
+	t.Helper()
+
+	raw, err := bson.Marshal(revision)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoder, err := bson.NewDecoder(bsonrw.NewBSONDocumentReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoder.DefaultDocumentM()
+
+	var stored Revision
+	if err := decoder.Decode(&stored); err != nil {
+		t.Fatal(err)
+	}
+	return &stored
+}
+
+func TestRevertRevisions(t *testing.T) {
+	states, revisions := history(t,
+		func(m *schema.Movie) { m.Title = "Alien 2" },
+		func(m *schema.Movie) { m.Title = "Alien 3"; m.Runtime = 114 },
+		func(m *schema.Movie) { m.Genres = nil },
+		func(m *schema.Movie) { m.Title = "Alien 5"; m.Genres = []schema.TMDBGenre{{ID: 28, Name: "Action"}} },
+		func(m *schema.Movie) { m.Genres = nil; m.IMDbID = "" },
+	)
+
+	tests := []struct {
+		name string
+		// revision is the revision reverted to, counting from the oldest
+		revision int
+	}{
+		{name: "latest revision changes nothing", revision: 5},
+		{name: "across an emptied list", revision: 4},
+		{name: "list set after it emptied", revision: 3},
+		{name: "field changed by several revisions", revision: 2},
+		{name: "first revision", revision: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			movie := states[len(states)-1]
+			since := revisions[:len(revisions)-tt.revision]
+
+			if err := revertRevisions(&movie, since); err != nil {
+				t.Fatal(err)
+			}
+
+			changes, err := diffMovies(&states[tt.revision], &movie)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(changes) > 0 {
+				t.Errorf("reverted movie differs from the revision in %v", (&Revision{Changes: changes}).changedFields())
+			}
+		})
+	}
+}
+
+func TestRevertRevisionsToInvalidMovie(t *testing.T) {
+	movie := baseMovie()
+	since := []*Revision{storedRevision(t, &Revision{Changes: []FieldChange{{Field: "title", Before: nil, After: "Alien"}}})}
+
+	if err := revertRevisions(&movie, since); !errors.Is(err, shared.ErrValidation) {
+		t.Fatalf("got %v, want a validation error", err)
+	}
+	if movie.Title != "Alien" {
+		t.Errorf("a failed revert changed the title to %q", movie.Title)
+	}
+}
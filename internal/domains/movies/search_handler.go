@@ -0,0 +1,101 @@
+package movies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+
+	"event-driven-go/internal/shared"
+)
+
+// SearchIndexHandler keeps the search index of this instance in step with
+// the catalog. The index is a local file, so the handler has to be
+// registered on an event bus consuming in a group of its own per instance:
+// in the shared group each instance would only see its share of the events.
+type SearchIndexHandler struct {
+	repository Repository
+	index      *SearchIndex
+	logger     *slog.Logger
+}
+
+func NewSearchIndexHandler(logger *slog.Logger, repository Repository, index *SearchIndex) *SearchIndexHandler {
+	return &SearchIndexHandler{
+		repository: repository,
+		index:      index,
+		logger:     logger.With(slog.String("domain", "movies"), slog.String("component", "search_index")),
+	}
+}
+
+// RegisterSearchIndexHandler subscribes the handler to the events that
+// change the text of movies.
+func RegisterSearchIndexHandler(eventBus *shared.EventBus, handler *SearchIndexHandler) {
+	eventBus.RegisterEventType(MovieCreatedEventType, &MovieCreatedEvent{}, handler)
+	eventBus.RegisterEventType(MovieUpdatedEventType, &MovieUpdatedEvent{}, handler)
+	eventBus.RegisterEventType(MovieDeletedEventType, &MovieDeletedEvent{}, handler)
+	eventBus.RegisterEventType(MovieImportCompletedEventType, &MovieImportCompletedEvent{}, handler)
+	eventBus.RegisterEventType(MoviesMergedEventType, &MoviesMergedEvent{}, handler)
+	eventBus.RegisterEventType(LocalizationsChangedEventType, &LocalizationsChangedEvent{}, handler)
+}
+
+func (h *SearchIndexHandler) Handle(ctx context.Context, event shared.Event) error {
+	switch e := event.(type) {
+	case *MovieCreatedEvent:
+		return h.refresh(ctx, e.MovieID)
+	case *MovieUpdatedEvent:
+		return h.refresh(ctx, e.MovieID)
+	case *MovieDeletedEvent:
+		return h.refresh(ctx, e.MovieID)
+	case *MovieImportCompletedEvent:
+		return h.handleMovieImportCompleted(ctx, e)
+	case *MoviesMergedEvent:
+		for _, id := range e.MergedIDs {
+			if err := h.refresh(ctx, id); err != nil {
+				return err
+			}
+		}
+		return h.refresh(ctx, e.MovieID)
+	case *LocalizationsChangedEvent:
+		return h.refresh(ctx, e.MovieID)
+	default:
+		return fmt.Errorf("unsupported event type: %T", event)
+	}
+}
+
+func (h *SearchIndexHandler) CanHandle(eventType string) bool {
+	return eventType == MovieCreatedEventType ||
+		eventType == MovieUpdatedEventType ||
+		eventType == MovieDeletedEventType ||
+		eventType == MovieImportCompletedEventType ||
+		eventType == MoviesMergedEventType ||
+		eventType == LocalizationsChangedEventType
+}
+
+// handleMovieImportCompleted indexes the catalog again, as an import
+// publishes no per-movie events.
+func (h *SearchIndexHandler) handleMovieImportCompleted(ctx context.Context, event *MovieImportCompletedEvent) error {
+	if event.Counts.Inserted+event.Counts.Updated == 0 {
+		return nil
+	}
+
+	indexed, err := h.index.IndexAll(ctx, h.repository)
+	if err != nil {
+		return fmt.Errorf("failed to index imported movies: %w", err)
+	}
+	h.logger.InfoContext(ctx, "imported movies indexed", slog.Int("movies", indexed))
+	return nil
+}
+
+// refresh indexes the current text of a movie, or removes it from the index
+// when it no longer exists.
+func (h *SearchIndexHandler) refresh(ctx context.Context, movieID string) error {
+	document, err := h.repository.GetSearchDocument(ctx, movieID)
+	if errors.Is(err, ErrMovieNotFound) {
+		return h.index.DeleteMovie(movieID)
+	}
+	if err != nil {
+		return err
+	}
+	h.logger.DebugContext(ctx, "movie indexed", slog.String("movie_id", movieID))
+	return h.index.IndexMovie(document)
+}
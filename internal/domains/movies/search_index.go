@@ -0,0 +1,282 @@
+package movies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/custom"
+	"github.com/blevesearch/bleve/v2/analysis/char/asciifolding"
+	"github.com/blevesearch/bleve/v2/analysis/lang/en"
+	"github.com/blevesearch/bleve/v2/analysis/token/lowercase"
+	"github.com/blevesearch/bleve/v2/analysis/token/porter"
+	"github.com/blevesearch/bleve/v2/analysis/tokenizer/unicode"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search/query"
+	schema "github.com/nameteos/my-movies-db-schema/mongodb"
+	bolterrors "go.etcd.io/bbolt/errors"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// SearchMatchLimit is how many of the best matches of a text search can
+	// be paged through.
+	SearchMatchLimit = 1000
+
+	searchBatchSize = 500
+
+	// englishAnalyzer matches English words regardless of case, accents and
+	// inflection, so that "amelie" finds "Amélie" and "hackers" "hacker".
+	englishAnalyzer = "english"
+	// foldedAnalyzer matches names regardless of case and accents, without
+	// stemming them.
+	foldedAnalyzer = "folded"
+)
+
+// searchFields are the indexed fields of a movie with the weight of a match
+// in them.
+var searchFields = []struct {
+	name     string
+	analyzer string
+	boost    float64
+}{
+	{"title", englishAnalyzer, 3},
+	{"original_title", foldedAnalyzer, 2},
+	{"translated_titles", foldedAnalyzer, 3},
+	{"cast", foldedAnalyzer, 2},
+	{"genres", englishAnalyzer, 1.5},
+	{"overview", englishAnalyzer, 1},
+	{"translated_overviews", foldedAnalyzer, 1},
+}
+
+// TextMatch is a movie matching a text search, with its relevance.
+type TextMatch struct {
+	ID    primitive.ObjectID
+	Score float64
+}
+
+// SearchDocument is the part of a movie the search index holds.
+type SearchDocument struct {
+	ID            primitive.ObjectID `bson:"_id"`
+	Title         string             `bson:"title"`
+	OriginalTitle string             `bson:"originaltitle"`
+	Overview      string             `bson:"overview"`
+	Cast          []string           `bson:"cast"`
+	Genres        []schema.TMDBGenre `bson:"genres"`
+
+	// Translations are indexed without stemming, which is only done for
+	// English.
+	Translations map[string]Translation `bson:"translations"`
+}
+
+func (d *SearchDocument) fields() map[string]interface{} {
+	genres := make([]string, 0, len(d.Genres))
+	for _, genre := range d.Genres {
+		genres = append(genres, genre.Name)
+	}
+	titles := make([]string, 0, len(d.Translations))
+	overviews := make([]string, 0, len(d.Translations))
+	for _, translation := range d.Translations {
+		titles = append(titles, translation.Title)
+		if translation.Overview != "" {
+			overviews = append(overviews, translation.Overview)
+		}
+	}
+	return map[string]interface{}{
+		"title":                d.Title,
+		"original_title":       d.OriginalTitle,
+		"translated_titles":    titles,
+		"overview":             d.Overview,
+		"translated_overviews": overviews,
+		"cast":                 d.Cast,
+		"genres":               genres,
+	}
+}
+
+// SearchIndex is the full text search read model of the catalog, an embedded
+// Bleve index the movie events keep up to date.
+type SearchIndex struct {
+	index  bleve.Index
+	logger *slog.Logger
+}
+
+// OpenSearchIndex opens the index at path, creating it when it does not
+// exist yet. An empty path keeps the index in memory. Only one process can
+// have an index open; the others give up after a second.
+func OpenSearchIndex(path string, logger *slog.Logger) (*SearchIndex, error) {
+	var index bleve.Index
+	var err error
+	if path == "" {
+		index, err = bleve.NewMemOnly(searchMapping())
+	} else {
+		index, err = bleve.OpenUsing(path, map[string]interface{}{"bolt_timeout": "1s"})
+		if errors.Is(err, bleve.ErrorIndexPathDoesNotExist) {
+			index, err = bleve.New(path, searchMapping())
+		}
+	}
+	if errors.Is(err, bolterrors.ErrTimeout) {
+		return nil, fmt.Errorf("search index %q is in use by another process", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to open search index %q: %w", path, err)
+	}
+
+	return &SearchIndex{index: index, logger: logger}, nil
+}
+
+func searchMapping() mapping.IndexMapping {
+	indexMapping := bleve.NewIndexMapping()
+	analyzers := map[string][]string{
+		englishAnalyzer: {en.PossessiveName, lowercase.Name, en.StopName, porter.Name},
+		foldedAnalyzer:  {lowercase.Name},
+	}
+	for name, tokenFilters := range analyzers {
+		err := indexMapping.AddCustomAnalyzer(name, map[string]interface{}{
+			"type":          custom.Name,
+			"char_filters":  []string{asciifolding.Name},
+			"tokenizer":     unicode.Name,
+			"token_filters": tokenFilters,
+		})
+		if err != nil {
+			panic(fmt.Sprintf("invalid search analyzer %s: %v", name, err))
+		}
+	}
+
+	movie := bleve.NewDocumentStaticMapping()
+	for _, field := range searchFields {
+		fieldMapping := bleve.NewTextFieldMapping()
+		fieldMapping.Analyzer = field.analyzer
+		fieldMapping.Store = false
+		fieldMapping.IncludeTermVectors = false
+		movie.AddFieldMappingsAt(field.name, fieldMapping)
+	}
+	indexMapping.DefaultMapping = movie
+
+	return indexMapping
+}
+
+// IndexMovie adds the movie to the index or replaces it.
+func (i *SearchIndex) IndexMovie(document *SearchDocument) error {
+	if err := i.index.Index(document.ID.Hex(), document.fields()); err != nil {
+		return fmt.Errorf("failed to index movie: %w", err)
+	}
+	return nil
+}
+
+// DeleteMovie removes the movie from the index, if it is there.
+func (i *SearchIndex) DeleteMovie(id string) error {
+	if err := i.index.Delete(id); err != nil {
+		return fmt.Errorf("failed to remove movie from search index: %w", err)
+	}
+	return nil
+}
+
+// IndexAll indexes every movie in the catalog, for an empty index or for
+// movies written without events such as by an import. It returns how many
+// were indexed.
+func (i *SearchIndex) IndexAll(ctx context.Context, repository Repository) (int, error) {
+	indexed := 0
+	batch := i.index.NewBatch()
+	flush := func() error {
+		if batch.Size() == 0 {
+			return nil
+		}
+		if err := i.index.Batch(batch); err != nil {
+			return fmt.Errorf("failed to index movies: %w", err)
+		}
+		indexed += batch.Size()
+		batch.Reset()
+		return nil
+	}
+
+	err := repository.ScanSearchDocuments(ctx, func(document *SearchDocument) error {
+		if err := batch.Index(document.ID.Hex(), document.fields()); err != nil {
+			return fmt.Errorf("failed to index movie: %w", err)
+		}
+		if batch.Size() == searchBatchSize {
+			return flush()
+		}
+		return nil
+	})
+	if err != nil {
+		return indexed, err
+	}
+	if err := flush(); err != nil {
+		return indexed, err
+	}
+
+	i.logger.DebugContext(ctx, "search index filled", slog.Int("movies", indexed))
+	return indexed, nil
+}
+
+// Count returns the number of movies in the index.
+func (i *SearchIndex) Count() (uint64, error) {
+	return i.index.DocCount()
+}
+
+// Search returns the movies matching any word of text, best matches first
+// and up to SearchMatchLimit. A match in the title weighs most, one in the
+// overview least.
+func (i *SearchIndex) Search(ctx context.Context, text string) ([]TextMatch, error) {
+	disjuncts := make([]query.Query, 0, len(searchFields))
+	for _, field := range searchFields {
+		match := bleve.NewMatchQuery(text)
+		match.SetField(field.name)
+		match.SetBoost(field.boost)
+		disjuncts = append(disjuncts, match)
+	}
+
+	request := bleve.NewSearchRequestOptions(bleve.NewDisjunctionQuery(disjuncts...), SearchMatchLimit, 0, false)
+	request.SortBy([]string{"-_score", "_id"})
+	result, err := i.index.SearchInContext(ctx, request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search movies: %w", err)
+	}
+
+	matches := make([]TextMatch, 0, len(result.Hits))
+	for _, hit := range result.Hits {
+		id, err := primitive.ObjectIDFromHex(hit.ID)
+		if err != nil {
+			continue
+		}
+		matches = append(matches, TextMatch{ID: id, Score: hit.Score})
+	}
+	return matches, nil
+}
+
+func (i *SearchIndex) Close() error {
+	return i.index.Close()
+}
+
+// RebuildSearchIndex replaces the index at path with one built from scratch
+// from the catalog. It fails while the application has the index open.
+func RebuildSearchIndex(ctx context.Context, path string, repository Repository, logger *slog.Logger) (int, error) {
+	if path == "" {
+		return 0, errors.New("no search index path, an index kept in memory is built at startup")
+	}
+
+	// Opening the old index makes sure no one is using it
+	old, err := OpenSearchIndex(path, logger)
+	if err != nil {
+		return 0, err
+	}
+	if err := old.Close(); err != nil {
+		return 0, fmt.Errorf("failed to close search index: %w", err)
+	}
+	if err := os.RemoveAll(path); err != nil {
+		return 0, fmt.Errorf("failed to remove search index: %w", err)
+	}
+
+	index, err := OpenSearchIndex(path, logger)
+	if err != nil {
+		return 0, err
+	}
+	indexed, err := index.IndexAll(ctx, repository)
+	if closeErr := index.Close(); err == nil && closeErr != nil {
+		err = fmt.Errorf("failed to close search index: %w", closeErr)
+	}
+	return indexed, err
+}
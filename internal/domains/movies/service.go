@@ -2,21 +2,56 @@ package movies
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
 
 	"event-driven-go/internal/shared"
 	schema "github.com/nameteos/my-movies-db-schema/mongodb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+// MovieReferenceRepository is implemented by repositories of other domains
+// that refer to movies, whose references have to follow a merge.
+type MovieReferenceRepository interface {
+	MergeMovieReferences(ctx context.Context, survivorID string, duplicateIDs []string) error
+}
+
+// TxRepositories are the transaction-bound repositories handed out by the
+// service's unit of work.
+type TxRepositories struct {
+	References []MovieReferenceRepository
+	Outbox     *shared.Outbox
+}
+
+// Service implements the movie use cases. Its search index may be nil in
+// commands that do not search.
 type Service struct {
 	repository Repository
+	embedder   Embedder
+	index      *SearchIndex
+	unitOfWork *shared.UnitOfWork[TxRepositories]
 	eventBus   *shared.EventBus
+	logger     *slog.Logger
 }
 
-func NewService(repository Repository, eventBus *shared.EventBus) *Service {
+func NewService(
+	repository Repository,
+	embedder Embedder,
+	index *SearchIndex,
+	unitOfWork *shared.UnitOfWork[TxRepositories],
+	eventBus *shared.EventBus,
+	logger *slog.Logger,
+) *Service {
 	return &Service{
 		repository: repository,
+		embedder:   embedder,
+		index:      index,
+		unitOfWork: unitOfWork,
 		eventBus:   eventBus,
+		logger:     logger.With(slog.String("domain", "movies")),
 	}
 }
 
@@ -26,7 +61,10 @@ func (s *Service) CreateMovie(ctx context.Context, movie *schema.Movie) (*schema
 		return nil, fmt.Errorf("movie cannot be nil")
 	}
 	if movie.Title == "" {
-		return nil, fmt.Errorf("movie title cannot be empty")
+		return nil, shared.NewFieldError("title", "must not be empty")
+	}
+	if err := shared.Authorize(ctx, shared.ActionWriteCatalog, ""); err != nil {
+		return nil, err
 	}
 
 	createdMovie, err := s.repository.CreateMovie(ctx, movie)
@@ -42,7 +80,7 @@ func (s *Service) CreateMovie(ctx context.Context, movie *schema.Movie) (*schema
 	if err := s.eventBus.Publish(ctx, event); err != nil {
 		// Log error but don't fail the operation since movie was created
 		// In a real system, you might want to implement compensation
-		fmt.Printf("Warning: failed to publish movie created event: %v\n", err)
+		s.logger.WarnContext(ctx, "failed to publish movie created event", slog.Any("error", err))
 	}
 
 	return createdMovie, nil
@@ -51,39 +89,119 @@ func (s *Service) CreateMovie(ctx context.Context, movie *schema.Movie) (*schema
 // GetMovieByID retrieves a movie by its ID
 func (s *Service) GetMovieByID(ctx context.Context, id string) (*schema.Movie, error) {
 	if id == "" {
-		return nil, fmt.Errorf("movie ID cannot be empty")
+		return nil, shared.NewFieldError("movie_id", "must not be empty")
 	}
 
 	return s.repository.GetMovieByID(ctx, id)
 }
 
-// UpdateMovie updates an existing movie and publishes an event
+// GetMoviesByIDs retrieves several movies at once, leaving out the IDs that
+// do not exist.
+func (s *Service) GetMoviesByIDs(ctx context.Context, ids []string) ([]*schema.Movie, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	return s.repository.GetMoviesByIDs(ctx, ids)
+}
+
+// UpdateMovie updates an existing movie, records the fields that changed as
+// a revision by the caller and publishes an event naming them. Nothing is
+// recorded or published when no field changed.
 func (s *Service) UpdateMovie(ctx context.Context, movie *schema.Movie) (*schema.Movie, error) {
 	// Validate input
 	if movie == nil {
 		return nil, fmt.Errorf("movie cannot be nil")
 	}
+	if err := shared.Authorize(ctx, shared.ActionWriteCatalog, ""); err != nil {
+		return nil, err
+	}
+
+	return s.updateMovie(ctx, movie, &Revision{})
+}
 
-	updatedMovie, err := s.repository.UpdateMovie(ctx, movie)
+func (s *Service) updateMovie(ctx context.Context, movie *schema.Movie, revision *Revision) (*schema.Movie, error) {
+	revision.Editor, _ = shared.UserIDFromContext(ctx)
+
+	updatedMovie, err := s.repository.UpdateMovie(ctx, movie, revision)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update movie: %w", err)
 	}
+	s.publishMovieUpdated(ctx, updatedMovie, revision)
+
+	return updatedMovie, nil
+}
+
+// publishMovieUpdated publishes an event naming the fields the revision
+// changed, if it changed any.
+func (s *Service) publishMovieUpdated(ctx context.Context, movie *schema.Movie, revision *Revision) {
+	if len(revision.Changes) == 0 {
+		return
+	}
 
-	// Publish movie updated event
-	event := NewMovieUpdatedEvent(updatedMovie.ID.Hex(), updatedMovie.Title)
+	event := NewMovieUpdatedEvent(movie.ID.Hex(), movie.Title, revision.changedFields())
 
 	if err := s.eventBus.Publish(ctx, event); err != nil {
 		// Log error but don't fail the operation
-		fmt.Printf("Warning: failed to publish movie updated event: %v\n", err)
+		s.logger.WarnContext(ctx, "failed to publish movie updated event", slog.Any("error", err))
 	}
+}
 
-	return updatedMovie, nil
+// ListRevisions pages through the changes made to a movie, newest first.
+// The history names its editors, so it is only open to those who may edit
+// the catalog.
+func (s *Service) ListRevisions(ctx context.Context, movieID string, page shared.PageRequest) (*shared.Page[*Revision], error) {
+	if err := shared.Authorize(ctx, shared.ActionWriteCatalog, ""); err != nil {
+		return nil, err
+	}
+	if _, err := s.GetMovieByID(ctx, movieID); err != nil {
+		return nil, err
+	}
+
+	return s.repository.ListRevisions(ctx, movieID, page.WithDefaults())
+}
+
+// RevertMovie puts the catalog fields of a movie back the way the revision
+// left them, undoing the revisions made since. The revert is recorded as a
+// revision of its own and can be reverted in turn.
+func (s *Service) RevertMovie(ctx context.Context, movieID, revisionID string) (*schema.Movie, error) {
+	if movieID == "" {
+		return nil, shared.NewFieldError("movie_id", "must not be empty")
+	}
+	if revisionID == "" {
+		return nil, shared.NewFieldError("revision_id", "must not be empty")
+	}
+	if err := shared.Authorize(ctx, shared.ActionWriteCatalog, ""); err != nil {
+		return nil, err
+	}
+
+	movie, err := s.repository.GetMovieByID(ctx, movieID)
+	if err != nil {
+		return nil, err
+	}
+	revision, err := s.repository.GetRevision(ctx, movieID, revisionID)
+	if err != nil {
+		return nil, err
+	}
+	since, err := s.repository.GetRevisionsSince(ctx, revision)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := revertRevisions(movie, since); err != nil {
+		return nil, err
+	}
+
+	return s.updateMovie(ctx, movie, &Revision{RevertedTo: &revision.ID})
 }
 
 // DeleteMovie deletes a movie and publishes an event
 func (s *Service) DeleteMovie(ctx context.Context, id string) error {
 	if id == "" {
-		return fmt.Errorf("movie ID cannot be empty")
+		return shared.NewFieldError("movie_id", "must not be empty")
+	}
+	if err := shared.Authorize(ctx, shared.ActionWriteCatalog, ""); err != nil {
+		return err
 	}
 
 	// Get movie first to get title for event
@@ -102,80 +220,586 @@ func (s *Service) DeleteMovie(ctx context.Context, id string) error {
 
 	if err := s.eventBus.Publish(ctx, event); err != nil {
 		// Log error but don't fail the operation since movie was deleted
-		fmt.Printf("Warning: failed to publish movie deleted event: %v\n", err)
+		s.logger.WarnContext(ctx, "failed to publish movie deleted event", slog.Any("error", err))
 	}
 
 	return nil
 }
 
-// SearchMovies searches for movies using various criteria
-func (s *Service) SearchMovies(ctx context.Context, query string, limit, offset int) ([]*schema.Movie, error) {
+// SearchMovies runs a full text search on titles in every language,
+// overview, cast and genres
+func (s *Service) SearchMovies(ctx context.Context, query string, page shared.PageRequest) (*shared.Page[*schema.Movie], error) {
 	if query == "" {
-		return nil, fmt.Errorf("search query cannot be empty")
+		return nil, shared.NewFieldError("query", "must not be empty")
+	}
+
+	matches, err := s.searchIndex(ctx, query)
+	if err != nil {
+		return nil, err
 	}
-	if limit <= 0 {
-		limit = 10 // Default limit
+	return s.repository.SearchMovies(ctx, matches, page.WithDefaults())
+}
+
+func (s *Service) searchIndex(ctx context.Context, text string) ([]TextMatch, error) {
+	if s.index == nil {
+		return nil, errors.New("search index is not open")
 	}
-	if offset < 0 {
-		offset = 0
+	return s.index.Search(ctx, text)
+}
+
+// FindMovies returns the movies matching all filters of the query, with the
+// genres, decades and languages of all matches counted.
+func (s *Service) FindMovies(ctx context.Context, query MovieQuery) (*MovieSearchResult, error) {
+	query.Text = strings.TrimSpace(query.Text)
+	query.Director = strings.TrimSpace(query.Director)
+	query.Genres = normalizeList(query.Genres)
+	query.Cast = normalizeList(query.Cast)
+	if fields := query.validate(); len(fields) > 0 {
+		return nil, shared.NewValidationError("invalid movie query", fields)
 	}
 
-	return s.repository.SearchMovies(ctx, query, limit, offset)
+	if query.Sort == "" {
+		query.Sort = SortReleaseDate
+		if query.Text != "" {
+			query.Sort = SortRelevance
+		}
+	}
+	query.Page = query.Page.WithDefaults()
+
+	if query.Text != "" {
+		matches, err := s.searchIndex(ctx, query.Text)
+		if err != nil {
+			return nil, err
+		}
+		query.matches = matches
+	}
+	result, err := s.repository.FindMovies(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.LocalizeMovies(ctx, result.Page.Items, query.AcceptLanguage); err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
 // GetMoviesByGenre retrieves movies by genre
-func (s *Service) GetMoviesByGenre(ctx context.Context, genre string, limit, offset int) ([]*schema.Movie, error) {
+func (s *Service) GetMoviesByGenre(ctx context.Context, genre string, page shared.PageRequest) (*shared.Page[*schema.Movie], error) {
 	if genre == "" {
-		return nil, fmt.Errorf("genre cannot be empty")
-	}
-	if limit <= 0 {
-		limit = 10
-	}
-	if offset < 0 {
-		offset = 0
+		return nil, shared.NewFieldError("genre", "must not be empty")
 	}
 
-	return s.repository.GetMoviesByGenre(ctx, genre, limit, offset)
+	return s.repository.GetMoviesByGenre(ctx, genre, page.WithDefaults())
 }
 
 // GetMoviesByYear retrieves movies by year
-func (s *Service) GetMoviesByYear(ctx context.Context, year int, limit, offset int) ([]*schema.Movie, error) {
+func (s *Service) GetMoviesByYear(ctx context.Context, year int, page shared.PageRequest) (*shared.Page[*schema.Movie], error) {
 	if year <= 0 {
-		return nil, fmt.Errorf("year must be valid")
-	}
-	if limit <= 0 {
-		limit = 10
-	}
-	if offset < 0 {
-		offset = 0
+		return nil, shared.NewFieldError("year", "must be a valid year")
 	}
 
-	return s.repository.GetMoviesByYear(ctx, year, limit, offset)
+	return s.repository.GetMoviesByYear(ctx, year, page.WithDefaults())
 }
 
 // GetMoviesByDirector retrieves movies by director
-func (s *Service) GetMoviesByDirector(ctx context.Context, director string, limit, offset int) ([]*schema.Movie, error) {
+func (s *Service) GetMoviesByDirector(ctx context.Context, director string, page shared.PageRequest) (*shared.Page[*schema.Movie], error) {
 	if director == "" {
-		return nil, fmt.Errorf("director cannot be empty")
+		return nil, shared.NewFieldError("director", "must not be empty")
 	}
-	if limit <= 0 {
-		limit = 10
+
+	return s.repository.GetMoviesByDirector(ctx, director, page.WithDefaults())
+}
+
+// GetRecentMovies retrieves recently added movies
+func (s *Service) GetRecentMovies(ctx context.Context, page shared.PageRequest) (*shared.Page[*schema.Movie], error) {
+	return s.repository.GetRecentMovies(ctx, page.WithDefaults())
+}
+
+// GetMovieByExternalID looks a movie up by its ID in another catalog.
+func (s *Service) GetMovieByExternalID(ctx context.Context, source ExternalSource, id string) (*schema.Movie, error) {
+	if id == "" {
+		return nil, shared.NewFieldError("id", "must not be empty")
 	}
-	if offset < 0 {
-		offset = 0
+
+	return s.repository.GetMovieByExternalID(ctx, source, id)
+}
+
+func (s *Service) GetExternalIDs(ctx context.Context, id string) (*ExternalIDs, error) {
+	if id == "" {
+		return nil, shared.NewFieldError("movie_id", "must not be empty")
 	}
 
-	return s.repository.GetMoviesByDirector(ctx, director, limit, offset)
+	return s.repository.GetExternalIDs(ctx, id)
 }
 
-// GetRecentMovies retrieves recently added movies
-func (s *Service) GetRecentMovies(ctx context.Context, limit, offset int) ([]*schema.Movie, error) {
-	if limit <= 0 {
-		limit = 10
+// SetExternalIDs replaces the external IDs of a movie, recording a change
+// of the IMDb or TMDb ID as a revision by the caller. An ID another movie
+// already has is rejected; merge the movies instead.
+func (s *Service) SetExternalIDs(ctx context.Context, id string, ids ExternalIDs) (*ExternalIDs, error) {
+	if id == "" {
+		return nil, shared.NewFieldError("movie_id", "must not be empty")
+	}
+	if fields := ids.validate(); len(fields) > 0 {
+		return nil, shared.NewValidationError("invalid external IDs", fields)
+	}
+	if err := shared.Authorize(ctx, shared.ActionWriteCatalog, ""); err != nil {
+		return nil, err
+	}
+
+	revision := &Revision{}
+	revision.Editor, _ = shared.UserIDFromContext(ctx)
+	movie, err := s.repository.SetExternalIDs(ctx, id, ids, revision)
+	if err != nil {
+		return nil, err
+	}
+	s.publishMovieUpdated(ctx, movie, revision)
+
+	return &ids, nil
+}
+
+// GetLocalizedMovie retrieves a movie in the first language of an
+// Accept-Language value such as "de-AT,de;q=0.9,en;q=0.5" it is translated
+// to, falling back from a locale to its language and then to the original
+// title. The release date and release are those of the region of the first
+// locale the movie was released in.
+func (s *Service) GetLocalizedMovie(ctx context.Context, id string, acceptLanguage string) (*LocalizedMovie, error) {
+	movie, err := s.GetMovieByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	languages := parseAcceptLanguage(acceptLanguage)
+	if len(languages) == 0 {
+		return &LocalizedMovie{Movie: movie}, nil
+	}
+	localizations, err := s.repository.GetLocalizations(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return localize(movie, localizations, languages), nil
+}
+
+// LocalizeMovies puts the title, overview and release date of the movies in
+// place in the languages of an Accept-Language value, as GetLocalizedMovie
+// does for one movie.
+func (s *Service) LocalizeMovies(ctx context.Context, movies []*schema.Movie, acceptLanguage string) error {
+	languages := parseAcceptLanguage(acceptLanguage)
+	if len(languages) == 0 || len(movies) == 0 {
+		return nil
+	}
+
+	ids := make([]primitive.ObjectID, 0, len(movies))
+	for _, movie := range movies {
+		ids = append(ids, movie.ID)
+	}
+	localizations, err := s.repository.FindLocalizations(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, movie := range movies {
+		localize(movie, localizations[movie.ID], languages)
+	}
+	return nil
+}
+
+func (s *Service) GetLocalizations(ctx context.Context, id string) (*Localizations, error) {
+	if id == "" {
+		return nil, shared.NewFieldError("movie_id", "must not be empty")
+	}
+
+	localizations, err := s.repository.GetLocalizations(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	localizations.normalize()
+	return localizations, nil
+}
+
+// SetLocalizations replaces the translations and releases of a movie.
+func (s *Service) SetLocalizations(ctx context.Context, id string, localizations Localizations) (*Localizations, error) {
+	if id == "" {
+		return nil, shared.NewFieldError("movie_id", "must not be empty")
+	}
+	localizations.normalize()
+	if fields := localizations.validate(); len(fields) > 0 {
+		return nil, shared.NewValidationError("invalid localizations", fields)
+	}
+	if err := shared.Authorize(ctx, shared.ActionWriteCatalog, ""); err != nil {
+		return nil, err
+	}
+
+	movie, err := s.repository.GetMovieByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repository.SetLocalizations(ctx, id, localizations); err != nil {
+		return nil, err
+	}
+
+	event := NewLocalizationsChangedEvent(id, movie.Title, localizations.locales(), localizations.countries())
+
+	if err := s.eventBus.Publish(ctx, event); err != nil {
+		s.logger.WarnContext(ctx, "failed to publish localizations changed event", slog.Any("error", err))
+	}
+
+	return &localizations, nil
+}
+
+// MergeMovies merges duplicates of a film into the surviving movie. The
+// watchlist entries, watch history and ratings of the duplicates are moved
+// to the survivor in one transaction together with the merged event, then
+// the duplicates are deleted and the survivor takes over the external IDs
+// it lacks, which is recorded as a revision by the caller. Should the second
+// step fail, merging again completes it.
+func (s *Service) MergeMovies(ctx context.Context, survivorID string, duplicateIDs []string) (*schema.Movie, error) {
+	if survivorID == "" {
+		return nil, shared.NewFieldError("movie_id", "must not be empty")
+	}
+	duplicateIDs = slices.Compact(slices.Sorted(slices.Values(duplicateIDs)))
+	if len(duplicateIDs) == 0 {
+		return nil, shared.NewFieldError("duplicate_ids", "must not be empty")
+	}
+	if slices.Contains(duplicateIDs, survivorID) {
+		return nil, shared.NewFieldError("duplicate_ids", "must not contain the movie merged into")
+	}
+	if err := shared.Authorize(ctx, shared.ActionWriteCatalog, ""); err != nil {
+		return nil, err
+	}
+
+	survivor, err := s.repository.GetMovieByID(ctx, survivorID)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := s.repository.GetExternalIDs(ctx, survivorID)
+	if err != nil {
+		return nil, err
 	}
-	if offset < 0 {
-		offset = 0
+	merged := *ids
+	for _, duplicateID := range duplicateIDs {
+		other, err := s.repository.GetExternalIDs(ctx, duplicateID)
+		if err != nil {
+			return nil, err
+		}
+		if merged.conflicts(*other) {
+			return nil, shared.NewConflictError("movie " + duplicateID + " has different external IDs and is a different film")
+		}
+		merged = merged.fillFrom(*other)
 	}
 
-	return s.repository.GetRecentMovies(ctx, limit, offset)
+	err = s.unitOfWork.Do(ctx, func(ctx context.Context, repos TxRepositories) error {
+		for _, references := range repos.References {
+			if err := references.MergeMovieReferences(ctx, survivorID, duplicateIDs); err != nil {
+				return err
+			}
+		}
+
+		return repos.Outbox.Add(ctx, NewMoviesMergedEvent(survivorID, survivor.Title, duplicateIDs))
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to move references to merged movies: %w", err)
+	}
+
+	revision := &Revision{}
+	revision.Editor, _ = shared.UserIDFromContext(ctx)
+	movie, err := s.repository.MergeMovies(ctx, survivorID, duplicateIDs, merged, revision)
+	if err != nil {
+		return nil, err
+	}
+	s.publishMovieUpdated(ctx, movie, revision)
+	s.logger.InfoContext(ctx, "movies merged",
+		slog.Bool("audit", true),
+		slog.String("movie_id", survivorID),
+		slog.Any("merged_ids", duplicateIDs),
+	)
+
+	return movie, nil
+}
+
+// Autocomplete suggests movies with a title word starting with prefix,
+// ignoring case and accents, most popular first.
+func (s *Service) Autocomplete(ctx context.Context, prefix string, limit int) ([]*Suggestion, error) {
+	if strings.TrimSpace(prefix) == "" {
+		return nil, shared.NewFieldError("prefix", "must not be empty")
+	}
+	if limit == 0 {
+		limit = DefaultSuggestionLimit
+	}
+	if limit < 0 || limit > MaxSuggestionLimit {
+		return nil, shared.NewFieldError("limit", fmt.Sprintf("must be between 1 and %d", MaxSuggestionLimit))
+	}
+
+	return s.repository.Autocomplete(ctx, prefix, limit)
+}
+
+// SimilarMovies returns the k movies most alike the movie by their
+// embeddings. A movie the events have not embedded yet is embedded first.
+func (s *Service) SimilarMovies(ctx context.Context, movieID string, k int) ([]*MovieMatch, error) {
+	k, err := similarLimit(k)
+	if err != nil {
+		return nil, err
+	}
+
+	vector, err := s.repository.GetEmbedding(ctx, movieID, s.embedder.Model())
+	if err != nil {
+		return nil, err
+	}
+	if vector == nil {
+		movie, err := s.repository.GetMovieByID(ctx, movieID)
+		if err != nil {
+			return nil, err
+		}
+		embedding, err := embedMovie(ctx, s.embedder, movie)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.repository.SetEmbedding(ctx, movieID, embedding); err != nil {
+			return nil, err
+		}
+		vector = embedding.Embedding
+	}
+
+	return s.repository.NearestMovies(ctx, s.embedder.Model(), vector, k, movieID)
+}
+
+// SemanticSearch returns the k movies whose embeddings are closest to that
+// of the text, which describes a movie in free words. Embedding the text may
+// be a paid call to the provider, so it is only open to signed in users.
+func (s *Service) SemanticSearch(ctx context.Context, text string, k int) ([]*MovieMatch, error) {
+	if _, err := shared.AuthenticatedUserID(ctx); err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(text) == "" {
+		return nil, shared.NewFieldError("query", "must not be empty")
+	}
+	k, err := similarLimit(k)
+	if err != nil {
+		return nil, err
+	}
+
+	vectors, err := s.embedder.Embed(ctx, []string{text})
+	if err != nil {
+		return nil, fmt.Errorf("failed to embed query: %w", err)
+	}
+
+	return s.repository.NearestMovies(ctx, s.embedder.Model(), vectors[0], k, "")
+}
+
+func similarLimit(k int) (int, error) {
+	if k == 0 {
+		return DefaultSimilarLimit, nil
+	}
+	if k < 0 || k > MaxSimilarLimit {
+		return 0, shared.NewFieldError("limit", fmt.Sprintf("must be between 1 and %d", MaxSimilarLimit))
+	}
+	return k, nil
+}
+
+// FindDuplicates compares all movies by normalized title, release year and
+// runtime, returning the groups that look like the same film.
+func (s *Service) FindDuplicates(ctx context.Context) ([]DuplicateGroup, error) {
+	candidates := make(map[duplicateKey][]*MovieFingerprint)
+	err := s.repository.ScanFingerprints(ctx, func(movie *MovieFingerprint) error {
+		if key, ok := duplicateKeyOf(movie); ok {
+			candidates[key] = append(candidates[key], movie)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var groups []DuplicateGroup
+	for _, movies := range candidates {
+		if len(movies) > 1 {
+			groups = append(groups, duplicateGroups(movies)...)
+		}
+	}
+	slices.SortFunc(groups, func(a, b DuplicateGroup) int {
+		return strings.Compare(a.Movies[0].Title, b.Movies[0].Title)
+	})
+
+	return groups, nil
+}
+
+// CreateCollection adds a collection of existing movies, in the given order.
+func (s *Service) CreateCollection(ctx context.Context, input CollectionInput) (*Collection, error) {
+	movieIDs, fields := input.parse()
+	if len(fields) > 0 {
+		return nil, shared.NewValidationError("invalid collection", fields)
+	}
+	if err := shared.Authorize(ctx, shared.ActionWriteCatalog, ""); err != nil {
+		return nil, err
+	}
+
+	movies, err := s.collectionMovies(ctx, movieIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	collection, err := s.repository.CreateCollection(ctx, &Collection{
+		Name:        input.Name,
+		Description: input.Description,
+		MovieIDs:    movieIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+	collection.Movies = movies
+
+	event := NewCollectionCreatedEvent(collection.ID.Hex(), collection.Name, hexIDs(collection.MovieIDs))
+
+	if err := s.eventBus.Publish(ctx, event); err != nil {
+		s.logger.WarnContext(ctx, "failed to publish collection created event", slog.Any("error", err))
+	}
+
+	return collection, nil
+}
+
+// GetCollection returns a collection with its movies in order.
+func (s *Service) GetCollection(ctx context.Context, id string) (*Collection, error) {
+	if id == "" {
+		return nil, shared.NewFieldError("collection_id", "must not be empty")
+	}
+
+	collection, err := s.repository.GetCollectionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := hexIDs(collection.MovieIDs)
+	movies, err := s.GetMoviesByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[string]*schema.Movie, len(movies))
+	for _, movie := range movies {
+		byID[movie.ID.Hex()] = movie
+	}
+	collection.Movies = make([]*schema.Movie, 0, len(ids))
+	for _, id := range ids {
+		if movie, ok := byID[id]; ok {
+			collection.Movies = append(collection.Movies, movie)
+		}
+	}
+
+	return collection, nil
+}
+
+// ListCollections pages through the collections by name, without their
+// movies.
+func (s *Service) ListCollections(ctx context.Context, page shared.PageRequest) (*shared.Page[*Collection], error) {
+	return s.repository.ListCollections(ctx, page.WithDefaults())
+}
+
+// GetMovieCollections returns the collections a movie belongs to.
+func (s *Service) GetMovieCollections(ctx context.Context, movieID string) ([]*Collection, error) {
+	if _, err := s.GetMovieByID(ctx, movieID); err != nil {
+		return nil, err
+	}
+
+	return s.repository.GetMovieCollections(ctx, movieID)
+}
+
+// UpdateCollection replaces the name, description and movies of a
+// collection.
+func (s *Service) UpdateCollection(ctx context.Context, id string, input CollectionInput) (*Collection, error) {
+	if id == "" {
+		return nil, shared.NewFieldError("collection_id", "must not be empty")
+	}
+	movieIDs, fields := input.parse()
+	if len(fields) > 0 {
+		return nil, shared.NewValidationError("invalid collection", fields)
+	}
+	if err := shared.Authorize(ctx, shared.ActionWriteCatalog, ""); err != nil {
+		return nil, err
+	}
+
+	collection, err := s.repository.GetCollectionByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	movies, err := s.collectionMovies(ctx, movieIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	collection.Name = input.Name
+	collection.Description = input.Description
+	collection.MovieIDs = movieIDs
+	updated, err := s.repository.UpdateCollection(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+	updated.Movies = movies
+
+	event := NewCollectionUpdatedEvent(updated.ID.Hex(), updated.Name, hexIDs(updated.MovieIDs))
+
+	if err := s.eventBus.Publish(ctx, event); err != nil {
+		s.logger.WarnContext(ctx, "failed to publish collection updated event", slog.Any("error", err))
+	}
+
+	return updated, nil
+}
+
+// DeleteCollection removes a collection, leaving its movies in the catalog.
+func (s *Service) DeleteCollection(ctx context.Context, id string) error {
+	if id == "" {
+		return shared.NewFieldError("collection_id", "must not be empty")
+	}
+	if err := shared.Authorize(ctx, shared.ActionWriteCatalog, ""); err != nil {
+		return err
+	}
+
+	collection, err := s.repository.GetCollectionByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := s.repository.DeleteCollection(ctx, id); err != nil {
+		return err
+	}
+
+	event := NewCollectionDeletedEvent(id, collection.Name)
+
+	if err := s.eventBus.Publish(ctx, event); err != nil {
+		s.logger.WarnContext(ctx, "failed to publish collection deleted event", slog.Any("error", err))
+	}
+
+	return nil
+}
+
+// collectionMovies returns the movies of the IDs in order, failing with the
+// positions of those that are not in the catalog.
+func (s *Service) collectionMovies(ctx context.Context, ids []primitive.ObjectID) ([]*schema.Movie, error) {
+	movies, err := s.GetMoviesByIDs(ctx, hexIDs(ids))
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[primitive.ObjectID]*schema.Movie, len(movies))
+	for _, movie := range movies {
+		byID[movie.ID] = movie
+	}
+
+	ordered := make([]*schema.Movie, 0, len(ids))
+	fields := make(map[string]string)
+	for i, id := range ids {
+		movie, ok := byID[id]
+		if !ok {
+			fields[fmt.Sprintf("movie_ids[%d]", i)] = "must be a movie in the catalog"
+			continue
+		}
+		ordered = append(ordered, movie)
+	}
+	if len(fields) > 0 {
+		return nil, shared.NewValidationError("invalid collection", fields)
+	}
+
+	return ordered, nil
+}
+
+func hexIDs(ids []primitive.ObjectID) []string {
+	hex := make([]string, 0, len(ids))
+	for _, id := range ids {
+		hex = append(hex, id.Hex())
+	}
+	return hex
 }
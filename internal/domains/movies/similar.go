@@ -0,0 +1,265 @@
+package movies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+
+	schema "github.com/nameteos/my-movies-db-schema/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	DefaultSimilarLimit = 10
+	MaxSimilarLimit     = 100
+
+	// vectorIndex is the name Atlas gives the search index the schema
+	// creates without one.
+	vectorIndex = "default"
+)
+
+// movieProjection leaves the embeddings out of the movies read for clients,
+// which have no use for thousands of numbers per movie.
+var movieProjection = bson.M{fieldEmbeddings: 0}
+
+// MovieMatch is a movie found by similarity. Score is the cosine similarity
+// scaled to 0 to 1, as Atlas vector search reports it.
+type MovieMatch struct {
+	Movie *schema.Movie `json:"movie"`
+	Score float64       `json:"score"`
+}
+
+func embeddingPath(model string) string {
+	return fieldEmbeddings + "." + embeddingKey(model) + ".embedding"
+}
+
+// GetEmbedding returns the model's embedding of a movie, or nil when it has
+// not been computed yet.
+func (r *MongoRepository) GetEmbedding(ctx context.Context, id string, model string) ([]float32, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
+	}
+
+	var document struct {
+		Embeddings map[string]schema.EmbeddingObject `bson:"embeddings"`
+	}
+	projection := bson.M{embeddingPath(model): 1}
+	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}, options.FindOne().SetProjection(projection)).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrMovieNotFound
+		}
+		return nil, fmt.Errorf("failed to get embedding: %w", err)
+	}
+
+	return document.Embeddings[embeddingKey(model)].Embedding, nil
+}
+
+// SetEmbedding stores an embedding of a movie under its model, keeping
+// those of other models.
+func (r *MongoRepository) SetEmbedding(ctx context.Context, id string, embedding schema.EmbeddingObject) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
+	}
+
+	key := fieldEmbeddings + "." + embeddingKey(embedding.Model)
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": objectID}, bson.M{"$set": bson.M{key: embedding}})
+	if err != nil {
+		return fmt.Errorf("failed to store embedding: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return ErrMovieNotFound
+	}
+
+	return nil
+}
+
+// GetMoviesWithoutEmbedding returns up to limit movies the model has not
+// embedded.
+func (r *MongoRepository) GetMoviesWithoutEmbedding(ctx context.Context, model string, limit int) ([]*schema.Movie, error) {
+	cursor, err := r.collection.Find(ctx,
+		bson.M{embeddingPath(model): bson.M{"$exists": false}},
+		options.Find().SetProjection(movieProjection).SetLimit(int64(limit)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find movies without embedding: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var movies []*schema.Movie
+	if err := cursor.All(ctx, &movies); err != nil {
+		return nil, fmt.Errorf("failed to decode movies: %w", err)
+	}
+	return movies, nil
+}
+
+// NearestMovies returns the k movies whose embeddings of the model are the
+// most similar to vector, leaving out the movie excludeID. It uses the
+// vector index and compares the embeddings one by one when the index is
+// unavailable, as on MongoDB without Atlas Search.
+func (r *MongoRepository) NearestMovies(ctx context.Context, model string, vector []float32, k int, excludeID string) ([]*MovieMatch, error) {
+	matches, err := r.vectorSearch(ctx, model, vector, k+1)
+	if err != nil || len(matches) == 0 {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if !r.scanningEmbeddings.Swap(true) {
+			r.logger.WarnContext(ctx, "vector search unavailable, comparing embeddings one by one", slog.Any("error", err))
+		}
+		if matches, err = r.scanNearest(ctx, model, vector, k+1); err != nil {
+			return nil, err
+		}
+	}
+
+	matches = slices.DeleteFunc(matches, func(match *MovieMatch) bool { return match.Movie.ID.Hex() == excludeID })
+	return matches[:min(k, len(matches))], nil
+}
+
+// vectorSearch asks the vector index for the nearest movies. Atlas answers
+// an unknown index with no results rather than an error.
+func (r *MongoRepository) vectorSearch(ctx context.Context, model string, vector []float32, limit int) ([]*MovieMatch, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$vectorSearch", Value: bson.M{
+			"index":         vectorIndex,
+			"path":          embeddingPath(model),
+			"queryVector":   vector,
+			"numCandidates": min(max(limit*20, 100), 10000),
+			"limit":         limit,
+		}}},
+		{{Key: "$addFields", Value: bson.M{fieldScore: bson.M{"$meta": "vectorSearchScore"}}}},
+		{{Key: "$project", Value: movieProjection}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search vectors: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var results []*scoredMovie
+	if err := cursor.All(ctx, &results); err != nil {
+		return nil, fmt.Errorf("failed to decode movies: %w", err)
+	}
+
+	matches := make([]*MovieMatch, 0, len(results))
+	for _, result := range results {
+		matches = append(matches, &MovieMatch{Movie: &result.Movie, Score: result.Score})
+	}
+	return matches, nil
+}
+
+// scanNearest compares vector with every embedding of the model, keeping
+// the limit most similar.
+func (r *MongoRepository) scanNearest(ctx context.Context, model string, vector []float32, limit int) ([]*MovieMatch, error) {
+	path := embeddingPath(model)
+	cursor, err := r.collection.Find(ctx,
+		bson.M{path: bson.M{"$exists": true}},
+		options.Find().SetProjection(bson.M{path: 1}).SetBatchSize(1000),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan embeddings: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	nearest := newNearestList(limit)
+	for cursor.Next(ctx) {
+		var document struct {
+			ID         primitive.ObjectID                `bson:"_id"`
+			Embeddings map[string]schema.EmbeddingObject `bson:"embeddings"`
+		}
+		if err := cursor.Decode(&document); err != nil {
+			return nil, fmt.Errorf("failed to decode embedding: %w", err)
+		}
+
+		nearest.add(document.ID, vectorScore(vector, document.Embeddings[embeddingKey(model)].Embedding))
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("failed to scan embeddings: %w", err)
+	}
+
+	ids := make([]string, 0, len(nearest.candidates))
+	for _, c := range nearest.candidates {
+		ids = append(ids, c.id.Hex())
+	}
+	movies, err := r.GetMoviesByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[primitive.ObjectID]*schema.Movie, len(movies))
+	for _, movie := range movies {
+		byID[movie.ID] = movie
+	}
+
+	matches := make([]*MovieMatch, 0, len(nearest.candidates))
+	for _, c := range nearest.candidates {
+		if movie, ok := byID[c.id]; ok {
+			matches = append(matches, &MovieMatch{Movie: movie, Score: c.score})
+		}
+	}
+	return matches, nil
+}
+
+type nearestCandidate struct {
+	id    primitive.ObjectID
+	score float64
+}
+
+// nearestList keeps the limit best scored movies added to it, best first.
+// Of movies scoring the same, the one added first ranks higher.
+type nearestList struct {
+	limit      int
+	candidates []nearestCandidate
+}
+
+func newNearestList(limit int) *nearestList {
+	return &nearestList{limit: limit, candidates: make([]nearestCandidate, 0, limit+1)}
+}
+
+func (l *nearestList) add(id primitive.ObjectID, score float64) {
+	if len(l.candidates) == l.limit && score <= l.candidates[l.limit-1].score {
+		return
+	}
+	i, _ := slices.BinarySearchFunc(l.candidates, score, func(c nearestCandidate, score float64) int {
+		// Descending by score, after those scoring the same
+		if c.score >= score {
+			return -1
+		}
+		return 1
+	})
+	l.candidates = slices.Insert(l.candidates, i, nearestCandidate{id: id, score: score})
+	if len(l.candidates) > l.limit {
+		l.candidates = l.candidates[:l.limit]
+	}
+}
+
+// vectorScore is the cosine similarity of the vectors scaled to 0 to 1, as
+// Atlas vector search scores matches.
+func vectorScore(a, b []float32) float64 {
+	return (1 + cosineSimilarity(a, b)) / 2
+}
+
+// cosineSimilarity is 0 for vectors of different lengths or without length.
+func cosineSimilarity(a, b []float32) float64 {
+	if len(a) != len(b) {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		normA += float64(a[i]) * float64(a[i])
+		normB += float64(b[i]) * float64(b[i])
+	}
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / math.Sqrt(normA*normB)
+}
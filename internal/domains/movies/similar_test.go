@@ -0,0 +1,109 @@
+package movies
+
+import (
+	"slices"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestCosineSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []float32
+		want float64
+	}{
+		{name: "same direction", a: []float32{1, 2, 3}, b: []float32{2, 4, 6}, want: 1},
+		{name: "opposite", a: []float32{1, 0}, b: []float32{-1, 0}, want: -1},
+		{name: "orthogonal", a: []float32{1, 0}, b: []float32{0, 1}, want: 0},
+		{name: "different lengths", a: []float32{1, 0}, b: []float32{1, 0, 0}, want: 0},
+		{name: "zero vector", a: []float32{0, 0}, b: []float32{1, 0}, want: 0},
+		{name: "missing embedding", a: []float32{1, 0}, b: nil, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := cosineSimilarity(tt.a, tt.b); !closeTo(got, tt.want) {
+				t.Errorf("got %f, want %f", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNearestListRanksBestFirst(t *testing.T) {
+	query := embed(t, "An alien creature hunts the crew of a space ship")[0]
+	texts := []string{
+		"A chef opens a small restaurant in Paris",
+		"The crew of a space ship fights an alien creature",
+		"Space explorers travel through a wormhole",
+		"An alien creature hunts the crew of a space ship",
+		"Two lawyers argue a murder case",
+	}
+	vectors := embed(t, texts...)
+	ids := make([]primitive.ObjectID, len(texts))
+	for i := range ids {
+		ids[i] = primitive.NewObjectID()
+	}
+
+	nearest := newNearestList(3)
+	for i, vector := range vectors {
+		nearest.add(ids[i], vectorScore(query, vector))
+	}
+
+	want := []primitive.ObjectID{ids[3], ids[1], ids[2]}
+	if len(nearest.candidates) != len(want) {
+		t.Fatalf("kept %d movies, want %d", len(nearest.candidates), len(want))
+	}
+	for i, candidate := range nearest.candidates {
+		if candidate.id != want[i] {
+			t.Errorf("rank %d is %q, want %q", i+1, texts[slices.Index(ids, candidate.id)], texts[slices.Index(ids, want[i])])
+		}
+		if i > 0 && candidate.score > nearest.candidates[i-1].score {
+			t.Errorf("rank %d scores %f, more than rank %d", i+1, candidate.score, i)
+		}
+	}
+	if !closeTo(nearest.candidates[0].score, 1) {
+		t.Errorf("the same text scored %f, want 1", nearest.candidates[0].score)
+	}
+}
+
+func TestNearestListKeepsTheLimit(t *testing.T) {
+	ids := make([]primitive.ObjectID, 6)
+	for i := range ids {
+		ids[i] = primitive.NewObjectID()
+	}
+
+	nearest := newNearestList(3)
+	for i, score := range []float64{0.2, 0.9, 0.5, 0.9, 0.1, 0.7} {
+		nearest.add(ids[i], score)
+	}
+
+	// Of equal scores the movie seen first ranks higher
+	want := []nearestCandidate{{ids[1], 0.9}, {ids[3], 0.9}, {ids[5], 0.7}}
+	if len(nearest.candidates) != len(want) {
+		t.Fatalf("kept %d movies, want %d", len(nearest.candidates), len(want))
+	}
+	for i := range want {
+		if nearest.candidates[i] != want[i] {
+			t.Errorf("rank %d is %v, want %v", i+1, nearest.candidates[i], want[i])
+		}
+	}
+}
+
+func TestNearestListWithFewerMovies(t *testing.T) {
+	nearest := newNearestList(10)
+	nearest.add(primitive.NewObjectID(), 0.4)
+	nearest.add(primitive.NewObjectID(), 0.6)
+
+	if len(nearest.candidates) != 2 {
+		t.Fatalf("kept %d movies, want 2", len(nearest.candidates))
+	}
+	if nearest.candidates[0].score != 0.6 {
+		t.Errorf("best score is %f, want 0.6", nearest.candidates[0].score)
+	}
+}
+
+func closeTo(a, b float64) bool {
+	const epsilon = 1e-6
+	return a-b < epsilon && b-a < epsilon
+}
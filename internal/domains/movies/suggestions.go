@@ -0,0 +1,275 @@
+package movies
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	DefaultSuggestionLimit = 10
+	MaxSuggestionLimit     = 25
+
+	// suggestionPrefixLength is the longest prefix stored. Longer queries are
+	// looked up by their first characters and checked against the title.
+	suggestionPrefixLength = 20
+	suggestionBatchSize    = 1000
+)
+
+// Suggestion is a movie offered while its title is being typed.
+type Suggestion struct {
+	MovieID    string `json:"movie_id"`
+	Title      string `json:"title"`
+	Year       int    `json:"year,omitempty"`
+	Popularity int    `json:"popularity"`
+}
+
+// PopularityCounter counts the interest users show in movies, which ranks
+// their suggestions.
+type PopularityCounter interface {
+	AddPopularity(ctx context.Context, movieID string, delta int) error
+}
+
+// suggestionDocument is a movie in the suggestion index, which is kept up to
+// date by the movie events. Prefixes holds the edge n-grams of the folded
+// title starting at each of its words, so that "mat" and "the mat" both find
+// "The Matrix".
+type suggestionDocument struct {
+	ID         primitive.ObjectID `bson:"_id"`
+	Title      string             `bson:"title"`
+	Year       int                `bson:"year"`
+	Folded     string             `bson:"folded"`
+	Prefixes   []string           `bson:"prefixes,omitempty"`
+	Popularity int                `bson:"popularity"`
+}
+
+// suggestionSource is the part of a movie its suggestion is made of.
+type suggestionSource struct {
+	ID          primitive.ObjectID `bson:"_id"`
+	Title       string             `bson:"title"`
+	ReleaseDate string             `bson:"releasedate"`
+}
+
+var suggestionProjection = bson.M{fieldTitle: 1, fieldReleaseDate: 1}
+
+// update sets the title fields of the suggestion, keeping the popularity
+// counted so far.
+func (s suggestionSource) update() bson.M {
+	folded := foldTitle(s.Title)
+	year := 0
+	if len(s.ReleaseDate) >= 4 {
+		year, _ = strconv.Atoi(s.ReleaseDate[:4])
+	}
+	return bson.M{
+		"$set": bson.M{
+			"title":    s.Title,
+			"year":     year,
+			"folded":   folded,
+			"prefixes": titlePrefixes(folded),
+		},
+		"$setOnInsert": bson.M{"popularity": 0},
+	}
+}
+
+// titlePrefixes returns the edge n-grams of a folded title and of each of its
+// trailing word sequences, up to suggestionPrefixLength characters.
+func titlePrefixes(folded string) []string {
+	words := strings.Fields(folded)
+	seen := make(map[string]bool)
+	var prefixes []string
+	for i := range words {
+		runes := []rune(strings.Join(words[i:], " "))
+		for n := 1; n <= len(runes) && n <= suggestionPrefixLength; n++ {
+			prefix := string(runes[:n])
+			if runes[n-1] == ' ' || seen[prefix] {
+				continue
+			}
+			seen[prefix] = true
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	return prefixes
+}
+
+// Autocomplete returns the movies with a title word starting with prefix,
+// ignoring case and accents, most popular first.
+func (r *MongoRepository) Autocomplete(ctx context.Context, prefix string, limit int) ([]*Suggestion, error) {
+	folded := foldTitle(prefix)
+	if folded == "" {
+		return []*Suggestion{}, nil
+	}
+
+	runes := []rune(folded)
+	filter := bson.M{"prefixes": strings.TrimSpace(string(runes[:min(len(runes), suggestionPrefixLength)]))}
+	if len(runes) > suggestionPrefixLength {
+		filter["folded"] = bson.M{"$regex": "(^| )" + regexp.QuoteMeta(folded)}
+	}
+
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "popularity", Value: -1}, {Key: "title", Value: 1}}).
+		SetLimit(int64(limit)).
+		SetProjection(bson.M{"prefixes": 0})
+	cursor, err := r.suggestions.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find suggestions: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var documents []suggestionDocument
+	if err := cursor.All(ctx, &documents); err != nil {
+		return nil, fmt.Errorf("failed to decode suggestions: %w", err)
+	}
+
+	suggestions := make([]*Suggestion, 0, len(documents))
+	for _, document := range documents {
+		suggestions = append(suggestions, &Suggestion{
+			MovieID:    document.ID.Hex(),
+			Title:      document.Title,
+			Year:       document.Year,
+			Popularity: document.Popularity,
+		})
+	}
+	return suggestions, nil
+}
+
+// RefreshSuggestion indexes the current title and year of a movie, or drops
+// its suggestion when the movie no longer exists. Reading the movie rather
+// than the event makes it safe for events to arrive late or twice.
+func (r *MongoRepository) RefreshSuggestion(ctx context.Context, id string) error {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
+	}
+
+	var source suggestionSource
+	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}, options.FindOne().SetProjection(suggestionProjection)).Decode(&source)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		if _, err := r.suggestions.DeleteOne(ctx, bson.M{"_id": objectID}); err != nil {
+			return fmt.Errorf("failed to delete suggestion: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get movie: %w", err)
+	}
+
+	if _, err := r.suggestions.UpdateOne(ctx, bson.M{"_id": objectID}, source.update(), options.Update().SetUpsert(true)); err != nil {
+		return fmt.Errorf("failed to update suggestion: %w", err)
+	}
+	return nil
+}
+
+// RebuildSuggestions indexes every movie in the catalog, for movies written
+// without events such as by an import. It returns how many were indexed.
+func (r *MongoRepository) RebuildSuggestions(ctx context.Context) (int, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{}, options.Find().SetProjection(suggestionProjection).SetBatchSize(suggestionBatchSize))
+	if err != nil {
+		return 0, fmt.Errorf("failed to scan movies: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	indexed := 0
+	models := make([]mongo.WriteModel, 0, suggestionBatchSize)
+	flush := func() error {
+		if len(models) == 0 {
+			return nil
+		}
+		if _, err := r.suggestions.BulkWrite(ctx, models, options.BulkWrite().SetOrdered(false)); err != nil {
+			return fmt.Errorf("failed to write suggestions: %w", err)
+		}
+		indexed += len(models)
+		models = models[:0]
+		return nil
+	}
+
+	for cursor.Next(ctx) {
+		var source suggestionSource
+		if err := cursor.Decode(&source); err != nil {
+			return indexed, fmt.Errorf("failed to decode movie: %w", err)
+		}
+		models = append(models, mongo.NewUpdateOneModel().
+			SetFilter(bson.M{"_id": source.ID}).
+			SetUpdate(source.update()).
+			SetUpsert(true))
+		if len(models) == suggestionBatchSize {
+			if err := flush(); err != nil {
+				return indexed, err
+			}
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return indexed, fmt.Errorf("failed to scan movies: %w", err)
+	}
+	if err := flush(); err != nil {
+		return indexed, err
+	}
+
+	r.logger.DebugContext(ctx, "suggestions rebuilt", slog.Int("movies", indexed))
+	return indexed, nil
+}
+
+// MergeSuggestions adds the popularity of the duplicates to the survivor and
+// drops their suggestions.
+func (r *MongoRepository) MergeSuggestions(ctx context.Context, survivorID string, duplicateIDs []string) error {
+	survivor, err := primitive.ObjectIDFromHex(survivorID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
+	}
+	duplicates := make([]primitive.ObjectID, 0, len(duplicateIDs))
+	for _, id := range duplicateIDs {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
+		}
+		duplicates = append(duplicates, objectID)
+	}
+
+	cursor, err := r.suggestions.Find(ctx, bson.M{"_id": bson.M{"$in": duplicates}}, options.Find().SetProjection(bson.M{"popularity": 1}))
+	if err != nil {
+		return fmt.Errorf("failed to find merged suggestions: %w", err)
+	}
+	var documents []suggestionDocument
+	if err := cursor.All(ctx, &documents); err != nil {
+		return fmt.Errorf("failed to decode merged suggestions: %w", err)
+	}
+
+	popularity := 0
+	for _, document := range documents {
+		popularity += document.Popularity
+	}
+	if popularity > 0 {
+		if _, err := r.suggestions.UpdateOne(ctx, bson.M{"_id": survivor}, bson.M{"$inc": bson.M{"popularity": popularity}}); err != nil {
+			return fmt.Errorf("failed to update suggestion: %w", err)
+		}
+	}
+	if _, err := r.suggestions.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": duplicates}}); err != nil {
+		return fmt.Errorf("failed to delete merged suggestions: %w", err)
+	}
+	return nil
+}
+
+// AddPopularity changes the popularity of a movie's suggestion by delta,
+// never taking it below zero. Movies not indexed yet are left alone.
+func (r *MongoRepository) AddPopularity(ctx context.Context, movieID string, delta int) error {
+	objectID, err := primitive.ObjectIDFromHex(movieID)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrInvalidMovieID, err)
+	}
+
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"popularity": bson.M{"$max": bson.A{0, bson.M{"$add": bson.A{"$popularity", delta}}}},
+	}}}}
+	if _, err := r.suggestions.UpdateOne(ctx, bson.M{"_id": objectID}, update); err != nil {
+		return fmt.Errorf("failed to update popularity: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package movies
+
+import "event-driven-go/internal/shared"
+
+var (
+	ErrMovieNotFound  = shared.NewNotFoundError("movie")
+	ErrInvalidMovieID = shared.NewFieldError("id", "must be a 24 character hex ObjectID")
+
+	ErrInvalidExternalSource = shared.NewFieldError("source", "must be one of imdb, tmdb or wikidata")
+	ErrInvalidExternalID     = shared.NewFieldError("id", "is not an ID of the source")
+	ErrDuplicateExternalID   = shared.NewAlreadyExistsError("another movie has the same external ID")
+
+	ErrCollectionNotFound  = shared.NewNotFoundError("collection")
+	ErrInvalidCollectionID = shared.NewFieldError("id", "must be a 24 character hex ObjectID")
+
+	ErrRevisionNotFound  = shared.NewNotFoundError("revision")
+	ErrInvalidRevisionID = shared.NewFieldError("revision_id", "must be a 24 character hex ObjectID")
+)
+
+// Document keys of schema.Movie fields without explicit bson tags, which the
+// driver stores under their lowercased Go field names.
+const (
+	fieldTitle         = "title"
+	fieldOriginalTitle = "originaltitle"
+	fieldOverview      = "overview"
+	fieldGenreName     = "genres.name"
+	fieldReleaseDate   = "releasedate"
+	fieldRuntime       = "runtime"
+	fieldDirector      = "director"
+	fieldCast          = "cast"
+	fieldLanguage      = "originallanguage"
+	fieldCreatedAt     = "created_at"
+	fieldUpdatedAt     = "updated_at"
+	fieldExternalID    = "externalid"
+	fieldIMDbID        = "imdbid"
+	fieldWikidataID    = "wikidataid"
+	fieldEmbeddings    = "embeddings"
+	fieldTranslations  = "translations"
+	fieldReleases      = "releases"
+)
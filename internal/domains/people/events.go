@@ -0,0 +1,83 @@
+package people
+
+import (
+	"event-driven-go/internal/domains/movies"
+	"event-driven-go/internal/shared"
+)
+
+const (
+	PersonCreatedEventType       = "people_person_created"
+	MovieCreditsChangedEventType = "people_movie_credits_changed"
+)
+
+func init() {
+	handler := NewHandler(shared.Logger, nil)
+	shared.GlobalEventBus.RegisterEventType(PersonCreatedEventType, &PersonCreatedEvent{}, handler)
+	shared.GlobalEventBus.RegisterEventType(MovieCreditsChangedEventType, &MovieCreditsChangedEvent{}, handler)
+}
+
+// RegisterMovieEventHandler registers the movie events the people domain
+// follows. An event bus takes one handler per topic and the movies domain
+// handles these on the application's, so the handler needs an event bus
+// with a consumer group of its own.
+func RegisterMovieEventHandler(eventBus *shared.EventBus, handler *Handler) {
+	eventBus.RegisterEventType(movies.MovieDeletedEventType, &movies.MovieDeletedEvent{}, handler)
+}
+
+type PersonCreatedEvent struct {
+	shared.BaseEvent
+	PersonID string `json:"person_id"`
+	Name     string `json:"name"`
+}
+
+func (e PersonCreatedEvent) GetPayload() interface{} {
+	return struct {
+		PersonID string `json:"person_id"`
+		Name     string `json:"name"`
+	}{
+		PersonID: e.PersonID,
+		Name:     e.Name,
+	}
+}
+
+func NewPersonCreatedEvent(personID, name string) *PersonCreatedEvent {
+	return &PersonCreatedEvent{
+		BaseEvent: shared.NewBaseEvent(PersonCreatedEventType),
+		PersonID:  personID,
+		Name:      name,
+	}
+}
+
+// MovieCreditsChangedEvent is published when the credits of a movie were
+// replaced. Added and Removed name the people who gained or lost a credit.
+type MovieCreditsChangedEvent struct {
+	shared.BaseEvent
+	MovieID string   `json:"movie_id"`
+	Title   string   `json:"title"`
+	Added   []string `json:"added"`
+	Removed []string `json:"removed"`
+}
+
+func (e MovieCreditsChangedEvent) GetPayload() interface{} {
+	return struct {
+		MovieID string   `json:"movie_id"`
+		Title   string   `json:"title"`
+		Added   []string `json:"added"`
+		Removed []string `json:"removed"`
+	}{
+		MovieID: e.MovieID,
+		Title:   e.Title,
+		Added:   e.Added,
+		Removed: e.Removed,
+	}
+}
+
+func NewMovieCreditsChangedEvent(movieID, title string, added, removed []string) *MovieCreditsChangedEvent {
+	return &MovieCreditsChangedEvent{
+		BaseEvent: shared.NewBaseEvent(MovieCreditsChangedEventType),
+		MovieID:   movieID,
+		Title:     title,
+		Added:     added,
+		Removed:   removed,
+	}
+}
@@ -0,0 +1,177 @@
+package people
+
+import (
+	"context"
+	"log/slog"
+
+	moviesdbv1 "event-driven-go/internal/gen/moviesdb/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GRPCServer implements moviesdb.v1.PeopleService on top of the service.
+type GRPCServer struct {
+	moviesdbv1.UnimplementedPeopleServiceServer
+	service *Service
+	logger  *slog.Logger
+}
+
+func NewGRPCServer(service *Service, logger *slog.Logger) *GRPCServer {
+	return &GRPCServer{
+		service: service,
+		logger:  logger.With(slog.String("domain", "people")),
+	}
+}
+
+func (s *GRPCServer) Register(server *grpc.Server) {
+	moviesdbv1.RegisterPeopleServiceServer(server, s)
+}
+
+func (s *GRPCServer) CreatePerson(ctx context.Context, req *moviesdbv1.CreatePersonRequest) (*moviesdbv1.Person, error) {
+	person := &Person{}
+	fromProtoPersonFields(req.GetFields()).applyTo(person)
+
+	created, err := s.service.CreatePerson(ctx, person)
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoPerson(created), nil
+}
+
+func (s *GRPCServer) GetPerson(ctx context.Context, req *moviesdbv1.GetPersonRequest) (*moviesdbv1.Person, error) {
+	person, err := s.service.GetPerson(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoPerson(person), nil
+}
+
+func (s *GRPCServer) UpdatePerson(ctx context.Context, req *moviesdbv1.UpdatePersonRequest) (*moviesdbv1.Person, error) {
+	person, err := s.service.GetPerson(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+	fromProtoPersonFields(req.GetFields()).applyTo(person)
+
+	updated, err := s.service.UpdatePerson(ctx, person)
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoPerson(updated), nil
+}
+
+func (s *GRPCServer) FindPeople(ctx context.Context, req *moviesdbv1.FindPeopleRequest) (*moviesdbv1.FindPeopleResponse, error) {
+	people, err := s.service.FindPeople(ctx, req.GetName(), int(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &moviesdbv1.FindPeopleResponse{People: make([]*moviesdbv1.Person, 0, len(people))}
+	for _, person := range people {
+		response.People = append(response.People, toProtoPerson(person))
+	}
+	return response, nil
+}
+
+func (s *GRPCServer) GetFilmography(ctx context.Context, req *moviesdbv1.GetFilmographyRequest) (*moviesdbv1.Filmography, error) {
+	filmography, err := s.service.GetFilmography(ctx, req.GetPersonId())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &moviesdbv1.Filmography{
+		Person:  toProtoPerson(filmography.Person),
+		Credits: make([]*moviesdbv1.FilmographyCredit, 0, len(filmography.Credits)),
+	}
+	for _, entry := range filmography.Credits {
+		response.Credits = append(response.Credits, &moviesdbv1.FilmographyCredit{
+			MovieId:     entry.Movie.ID.Hex(),
+			Title:       entry.Movie.Title,
+			ReleaseDate: entry.Movie.ReleaseDate,
+			Role:        string(entry.Role),
+			Character:   entry.Character,
+		})
+	}
+	return response, nil
+}
+
+func (s *GRPCServer) GetMovieCredits(ctx context.Context, req *moviesdbv1.GetMovieCreditsRequest) (*moviesdbv1.MovieCredits, error) {
+	credits, err := s.service.GetMovieCredits(ctx, req.GetMovieId())
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoMovieCredits(credits), nil
+}
+
+func (s *GRPCServer) SetMovieCredits(ctx context.Context, req *moviesdbv1.SetMovieCreditsRequest) (*moviesdbv1.MovieCredits, error) {
+	inputs := make([]CreditInput, 0, len(req.GetCredits()))
+	for _, credit := range req.GetCredits() {
+		inputs = append(inputs, CreditInput{
+			PersonID:  credit.GetPersonId(),
+			Role:      Role(credit.GetRole()),
+			Character: credit.GetCharacter(),
+		})
+	}
+
+	credits, err := s.service.SetMovieCredits(ctx, req.GetMovieId(), inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoMovieCredits(credits), nil
+}
+
+func fromProtoPersonFields(fields *moviesdbv1.PersonFields) *PersonRequest {
+	return &PersonRequest{
+		Name:      fields.GetName(),
+		Aliases:   fields.GetAliases(),
+		BirthDate: fields.GetBirthDate(),
+		ExternalIDs: ExternalIDs{
+			IMDb:     fields.GetExternalIds().GetImdb(),
+			TMDb:     int(fields.GetExternalIds().GetTmdb()),
+			Wikidata: fields.GetExternalIds().GetWikidata(),
+		},
+	}
+}
+
+func toProtoPerson(person *Person) *moviesdbv1.Person {
+	return &moviesdbv1.Person{
+		Id: person.ID.Hex(),
+		Fields: &moviesdbv1.PersonFields{
+			Name:      person.Name,
+			Aliases:   person.Aliases,
+			BirthDate: person.BirthDate,
+			ExternalIds: &moviesdbv1.PersonExternalIds{
+				Imdb:     person.ExternalIDs.IMDb,
+				Tmdb:     int32(person.ExternalIDs.TMDb),
+				Wikidata: person.ExternalIDs.Wikidata,
+			},
+		},
+		CreatedAt: timestamppb.New(person.CreatedAt),
+		UpdatedAt: timestamppb.New(person.UpdatedAt),
+	}
+}
+
+func toProtoMovieCredits(credits *MovieCredits) *moviesdbv1.MovieCredits {
+	return &moviesdbv1.MovieCredits{
+		MovieId: credits.MovieID,
+		Cast:    toProtoCreditedPeople(credits.Cast),
+		Crew:    toProtoCreditedPeople(credits.Crew),
+	}
+}
+
+func toProtoCreditedPeople(credited []*CreditedPerson) []*moviesdbv1.CreditedPerson {
+	result := make([]*moviesdbv1.CreditedPerson, 0, len(credited))
+	for _, c := range credited {
+		result = append(result, &moviesdbv1.CreditedPerson{
+			Person:    toProtoPerson(c.Person),
+			Role:      string(c.Role),
+			Character: c.Character,
+		})
+	}
+	return result
+}
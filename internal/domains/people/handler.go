@@ -0,0 +1,83 @@
+package people
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"event-driven-go/internal/domains/movies"
+	"event-driven-go/internal/shared"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// Handler removes the credits of deleted movies. Without a repository it only
+// logs the events.
+type Handler struct {
+	repository *Repository
+	logger     *slog.Logger
+}
+
+func NewHandler(logger *slog.Logger, repository *Repository) *Handler {
+	return &Handler{
+		repository: repository,
+		logger:     logger.With(slog.String("domain", "people")),
+	}
+}
+
+func (h *Handler) Handle(ctx context.Context, event shared.Event) error {
+	switch e := event.(type) {
+	case *PersonCreatedEvent:
+		return h.handlePersonCreated(ctx, e)
+	case *MovieCreditsChangedEvent:
+		return h.handleMovieCreditsChanged(ctx, e)
+	case *movies.MovieDeletedEvent:
+		return h.handleMovieDeleted(ctx, e)
+	default:
+		return fmt.Errorf("unsupported event type: %T", event)
+	}
+}
+
+func (h *Handler) CanHandle(eventType string) bool {
+	return eventType == PersonCreatedEventType ||
+		eventType == MovieCreditsChangedEventType ||
+		eventType == movies.MovieDeletedEventType
+}
+
+func (h *Handler) handlePersonCreated(ctx context.Context, event *PersonCreatedEvent) error {
+	h.logger.InfoContext(ctx, "person added",
+		slog.String("person_id", event.PersonID),
+		slog.String("name", event.Name),
+	)
+	return nil
+}
+
+func (h *Handler) handleMovieCreditsChanged(ctx context.Context, event *MovieCreditsChangedEvent) error {
+	h.logger.InfoContext(ctx, "movie credits changed",
+		slog.String("movie_id", event.MovieID),
+		slog.String("title", event.Title),
+		slog.Any("added", event.Added),
+		slog.Any("removed", event.Removed),
+	)
+	return nil
+}
+
+func (h *Handler) handleMovieDeleted(ctx context.Context, event *movies.MovieDeletedEvent) error {
+	if h.repository == nil {
+		return nil
+	}
+
+	movieID, err := primitive.ObjectIDFromHex(event.MovieID)
+	if err != nil {
+		return fmt.Errorf("invalid movie ID %q: %w", event.MovieID, err)
+	}
+	removed, err := h.repository.DeleteMovieCredits(ctx, movieID)
+	if err != nil {
+		return err
+	}
+
+	h.logger.InfoContext(ctx, "credits of deleted movie removed",
+		slog.String("movie_id", event.MovieID),
+		slog.Int64("credits", removed),
+	)
+	return nil
+}
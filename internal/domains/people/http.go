@@ -0,0 +1,242 @@
+package people
+
+import (
+	"log/slog"
+	"net/http"
+
+	"event-driven-go/internal/shared"
+)
+
+type HTTPHandler struct {
+	service *Service
+	logger  *slog.Logger
+}
+
+func NewHTTPHandler(service *Service, logger *slog.Logger) *HTTPHandler {
+	return &HTTPHandler{
+		service: service,
+		logger:  logger.With(slog.String("domain", "people")),
+	}
+}
+
+func (h *HTTPHandler) RegisterRoutes(router *shared.Router) {
+	router.Handle(shared.Route{
+		Method:   http.MethodPost,
+		Path:     "/people",
+		Tag:      "people",
+		Summary:  "Add a person who works on movies",
+		Request:  PersonRequest{},
+		Response: Person{},
+		Status:   http.StatusCreated,
+		Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusConflict, http.StatusUnprocessableEntity},
+		Auth:     true,
+	}, h.createPerson)
+	router.Handle(shared.Route{
+		Method:  http.MethodGet,
+		Path:    "/people",
+		Tag:     "people",
+		Summary: "People going by a name or alias",
+		Query: []shared.QueryParam{
+			{Name: "name", Required: true, Description: "Name or alias, case and accents ignored"},
+			{Name: "limit", Type: "integer", Description: "Number of people, 1 to 100 (default 20)"},
+		},
+		Response: PersonListResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusUnprocessableEntity},
+	}, h.findPeople)
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/people/{id}",
+		Tag:      "people",
+		Summary:  "Get a person",
+		Response: Person{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+	}, h.getPerson)
+	router.Handle(shared.Route{
+		Method:   http.MethodPut,
+		Path:     "/people/{id}",
+		Tag:      "people",
+		Summary:  "Replace the details of a person",
+		Request:  PersonRequest{},
+		Response: Person{},
+		Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity},
+		Auth:     true,
+	}, h.updatePerson)
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/people/{id}/filmography",
+		Tag:      "people",
+		Summary:  "Movies a person worked on, newest first",
+		Response: Filmography{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+	}, h.getFilmography)
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/movies/{id}/credits",
+		Tag:      "people",
+		Summary:  "Cast and crew of a movie in billing order",
+		Response: MovieCredits{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+	}, h.getMovieCredits)
+	router.Handle(shared.Route{
+		Method:   http.MethodPut,
+		Path:     "/movies/{id}/credits",
+		Tag:      "people",
+		Summary:  "Replace the credits of a movie, in billing order",
+		Request:  SetCreditsRequest{},
+		Response: MovieCredits{},
+		Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusUnprocessableEntity},
+		Auth:     true,
+	}, h.setMovieCredits)
+}
+
+// PersonRequest holds the editable fields of a person.
+type PersonRequest struct {
+	Name        string      `json:"name" openapi:"required"`
+	Aliases     []string    `json:"aliases,omitempty"`
+	BirthDate   string      `json:"birth_date,omitempty"`
+	ExternalIDs ExternalIDs `json:"external_ids"`
+}
+
+func (r *PersonRequest) applyTo(person *Person) {
+	person.Name = r.Name
+	person.Aliases = r.Aliases
+	person.BirthDate = r.BirthDate
+	person.ExternalIDs = r.ExternalIDs
+}
+
+type PersonListResponse struct {
+	Data []*Person `json:"data"`
+}
+
+type SetCreditsRequest struct {
+	Credits []CreditInput `json:"credits" openapi:"required"`
+}
+
+func (h *HTTPHandler) createPerson(w http.ResponseWriter, r *http.Request) {
+	var request PersonRequest
+	if err := shared.DecodeJSON(w, r, &request); err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	person := &Person{}
+	request.applyTo(person)
+
+	created, err := h.service.CreatePerson(r.Context(), person)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusCreated, created)
+}
+
+func (h *HTTPHandler) findPeople(w http.ResponseWriter, r *http.Request) {
+	limit, err := shared.QueryInt(r, "limit", DefaultPeopleLimit)
+	if err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	people, err := h.service.FindPeople(r.Context(), r.URL.Query().Get("name"), limit)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, PersonListResponse{Data: people})
+}
+
+func (h *HTTPHandler) getPerson(w http.ResponseWriter, r *http.Request) {
+	id, ok := shared.PathObjectID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	person, err := h.service.GetPerson(r.Context(), id)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, person)
+}
+
+func (h *HTTPHandler) updatePerson(w http.ResponseWriter, r *http.Request) {
+	id, ok := shared.PathObjectID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var request PersonRequest
+	if err := shared.DecodeJSON(w, r, &request); err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	person, err := h.service.GetPerson(r.Context(), id)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+	request.applyTo(person)
+
+	updated, err := h.service.UpdatePerson(r.Context(), person)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, updated)
+}
+
+func (h *HTTPHandler) getFilmography(w http.ResponseWriter, r *http.Request) {
+	id, ok := shared.PathObjectID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	filmography, err := h.service.GetFilmography(r.Context(), id)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, filmography)
+}
+
+func (h *HTTPHandler) getMovieCredits(w http.ResponseWriter, r *http.Request) {
+	id, ok := shared.PathObjectID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	credits, err := h.service.GetMovieCredits(r.Context(), id)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, credits)
+}
+
+func (h *HTTPHandler) setMovieCredits(w http.ResponseWriter, r *http.Request) {
+	id, ok := shared.PathObjectID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	var request SetCreditsRequest
+	if err := shared.DecodeJSON(w, r, &request); err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	credits, err := h.service.SetMovieCredits(r.Context(), id, request.Credits)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, credits)
+}
@@ -0,0 +1,282 @@
+package people
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// nameCollation compares names by their base letters, ignoring case and
+// accents.
+var nameCollation = &options.Collation{Locale: "en", Strength: 1}
+
+type Repository struct {
+	people  *mongo.Collection
+	credits *mongo.Collection
+	logger  *slog.Logger
+}
+
+func NewRepository(db *mongo.Database, logger *slog.Logger) *Repository {
+	return &Repository{
+		people:  db.Collection("people"),
+		credits: db.Collection("credits"),
+		logger:  logger.With(slog.String("repository", "people")),
+	}
+}
+
+func (r *Repository) CreateIndexes(ctx context.Context) error {
+	peopleIndexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "name", Value: 1}},
+			Options: options.Index().SetCollation(nameCollation),
+		},
+		{
+			Keys:    bson.D{{Key: "aliases", Value: 1}},
+			Options: options.Index().SetCollation(nameCollation),
+		},
+		{
+			Keys:    bson.D{{Key: "external_ids.imdb", Value: 1}},
+			Options: options.Index().SetUnique(true).SetSparse(true),
+		},
+		{
+			Keys:    bson.D{{Key: "external_ids.tmdb", Value: 1}},
+			Options: options.Index().SetUnique(true).SetSparse(true),
+		},
+		{
+			Keys:    bson.D{{Key: "external_ids.wikidata", Value: 1}},
+			Options: options.Index().SetUnique(true).SetSparse(true),
+		},
+	}
+	if _, err := r.people.Indexes().CreateMany(ctx, peopleIndexes); err != nil {
+		return fmt.Errorf("failed to create people indexes: %w", err)
+	}
+
+	// A movie's credits in billing order, and a person's filmography
+	creditIndexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "movie_id", Value: 1}, {Key: "order", Value: 1}}},
+		{Keys: bson.D{{Key: "person_id", Value: 1}}},
+	}
+	if _, err := r.credits.Indexes().CreateMany(ctx, creditIndexes); err != nil {
+		return fmt.Errorf("failed to create credit indexes: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Repository) CreatePerson(ctx context.Context, person *Person) (*Person, error) {
+	person.ID = primitive.NewObjectID()
+	person.CreatedAt = time.Now()
+	person.UpdatedAt = person.CreatedAt
+
+	_, err := r.people.InsertOne(ctx, person)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, ErrDuplicateExternalID
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create person: %w", err)
+	}
+	r.logger.DebugContext(ctx, "person created", slog.String("person_id", person.ID.Hex()))
+
+	return person, nil
+}
+
+func (r *Repository) GetPersonByID(ctx context.Context, id string) (*Person, error) {
+	objectID, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidPersonID, err)
+	}
+
+	var person Person
+	if err := r.people.FindOne(ctx, bson.M{"_id": objectID}).Decode(&person); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ErrPersonNotFound
+		}
+		return nil, fmt.Errorf("failed to get person: %w", err)
+	}
+
+	return &person, nil
+}
+
+// GetPeopleByIDs returns the people with the IDs by ID, leaving out those
+// that do not exist.
+func (r *Repository) GetPeopleByIDs(ctx context.Context, ids []primitive.ObjectID) (map[primitive.ObjectID]*Person, error) {
+	cursor, err := r.people.Find(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get people: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var people []*Person
+	if err := cursor.All(ctx, &people); err != nil {
+		return nil, fmt.Errorf("failed to decode people: %w", err)
+	}
+
+	byID := make(map[primitive.ObjectID]*Person, len(people))
+	for _, person := range people {
+		byID[person.ID] = person
+	}
+	return byID, nil
+}
+
+// FindPeopleByName returns the people with the name or an alias, ignoring
+// case and accents, by name.
+func (r *Repository) FindPeopleByName(ctx context.Context, name string, limit int) ([]*Person, error) {
+	filter := bson.M{"$or": bson.A{bson.M{"name": name}, bson.M{"aliases": name}}}
+	findOptions := options.Find().
+		SetCollation(nameCollation).
+		SetSort(bson.D{{Key: "name", Value: 1}, {Key: "_id", Value: 1}}).
+		SetLimit(int64(limit))
+	cursor, err := r.people.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find people: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	people := []*Person{}
+	if err := cursor.All(ctx, &people); err != nil {
+		return nil, fmt.Errorf("failed to decode people: %w", err)
+	}
+	return people, nil
+}
+
+// UpdatePerson replaces the name, aliases, birth date and external IDs of
+// the person.
+func (r *Repository) UpdatePerson(ctx context.Context, person *Person) (*Person, error) {
+	update := bson.M{"$set": bson.M{
+		"name":         person.Name,
+		"aliases":      person.Aliases,
+		"birth_date":   person.BirthDate,
+		"external_ids": person.ExternalIDs,
+		"updated_at":   time.Now(),
+	}}
+
+	var updated Person
+	err := r.people.FindOneAndUpdate(ctx, bson.M{"_id": person.ID}, update,
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&updated)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil, ErrDuplicateExternalID
+	}
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, ErrPersonNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to update person: %w", err)
+	}
+
+	return &updated, nil
+}
+
+// GetMovieCredits returns the credits of a movie in billing order.
+func (r *Repository) GetMovieCredits(ctx context.Context, movieID primitive.ObjectID) ([]*Credit, error) {
+	return r.findCredits(ctx, bson.M{"movie_id": movieID}, bson.D{{Key: "order", Value: 1}})
+}
+
+// GetPersonCredits returns the credits of a person.
+func (r *Repository) GetPersonCredits(ctx context.Context, personID primitive.ObjectID) ([]*Credit, error) {
+	return r.findCredits(ctx, bson.M{"person_id": personID}, bson.D{{Key: "_id", Value: 1}})
+}
+
+func (r *Repository) findCredits(ctx context.Context, filter bson.M, sort bson.D) ([]*Credit, error) {
+	cursor, err := r.credits.Find(ctx, filter, options.Find().SetSort(sort))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get credits: %w", err)
+	}
+	defer cursor.Close(ctx)
+
+	var credits []*Credit
+	if err := cursor.All(ctx, &credits); err != nil {
+		return nil, fmt.Errorf("failed to decode credits: %w", err)
+	}
+	return credits, nil
+}
+
+// ReplaceMovieCredits replaces the credits of a movie.
+func (r *Repository) ReplaceMovieCredits(ctx context.Context, movieID primitive.ObjectID, credits []*Credit) error {
+	if _, err := r.credits.DeleteMany(ctx, bson.M{"movie_id": movieID}); err != nil {
+		return fmt.Errorf("failed to remove credits: %w", err)
+	}
+	if len(credits) == 0 {
+		return nil
+	}
+
+	documents := make([]interface{}, 0, len(credits))
+	for _, credit := range credits {
+		credit.ID = primitive.NewObjectID()
+		credit.MovieID = movieID
+		documents = append(documents, credit)
+	}
+	if _, err := r.credits.InsertMany(ctx, documents); err != nil {
+		return fmt.Errorf("failed to add credits: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteMovieCredits removes the credits of a movie and returns how many
+// there were.
+func (r *Repository) DeleteMovieCredits(ctx context.Context, movieID primitive.ObjectID) (int64, error) {
+	result, err := r.credits.DeleteMany(ctx, bson.M{"movie_id": movieID})
+	if err != nil {
+		return 0, fmt.Errorf("failed to remove credits: %w", err)
+	}
+	return result.DeletedCount, nil
+}
+
+// MergeMovieReferences moves the credits of merged movies to the surviving
+// movie, after its own, dropping those it already has. Credits live in
+// MongoDB, so unlike the other references they are not rolled back with the
+// merge transaction.
+func (r *Repository) MergeMovieReferences(ctx context.Context, survivorID string, duplicateIDs []string) error {
+	survivor, err := primitive.ObjectIDFromHex(survivorID)
+	if err != nil {
+		return fmt.Errorf("invalid movie ID %q: %w", survivorID, err)
+	}
+	duplicates := make([]primitive.ObjectID, 0, len(duplicateIDs))
+	for _, id := range duplicateIDs {
+		objectID, err := primitive.ObjectIDFromHex(id)
+		if err != nil {
+			return fmt.Errorf("invalid movie ID %q: %w", id, err)
+		}
+		duplicates = append(duplicates, objectID)
+	}
+
+	credits, err := r.GetMovieCredits(ctx, survivor)
+	if err != nil {
+		return err
+	}
+	seen := make(map[string]bool, len(credits))
+	for _, credit := range credits {
+		seen[credit.key()] = true
+	}
+
+	merged, err := r.findCredits(ctx, bson.M{"movie_id": bson.M{"$in": duplicates}}, bson.D{{Key: "order", Value: 1}})
+	if err != nil {
+		return err
+	}
+	order := len(credits)
+	for _, credit := range merged {
+		if seen[credit.key()] {
+			continue
+		}
+		seen[credit.key()] = true
+
+		update := bson.M{"$set": bson.M{"movie_id": survivor, "order": order}}
+		if _, err := r.credits.UpdateByID(ctx, credit.ID, update); err != nil {
+			return fmt.Errorf("failed to move credit: %w", err)
+		}
+		order++
+	}
+
+	if _, err := r.credits.DeleteMany(ctx, bson.M{"movie_id": bson.M{"$in": duplicates}}); err != nil {
+		return fmt.Errorf("failed to remove merged credits: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,310 @@
+package people
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"event-driven-go/internal/shared"
+	schema "github.com/nameteos/my-movies-db-schema/mongodb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	DefaultPeopleLimit = 20
+	MaxPeopleLimit     = 100
+
+	// MaxCredits is how many credits a movie can have.
+	MaxCredits = 500
+)
+
+// MovieLookup gives the people domain read access to the movies that credits
+// reference.
+type MovieLookup interface {
+	GetMovieByID(ctx context.Context, id string) (*schema.Movie, error)
+	GetMoviesByIDs(ctx context.Context, ids []string) ([]*schema.Movie, error)
+}
+
+type Service struct {
+	repository *Repository
+	movies     MovieLookup
+	eventBus   *shared.EventBus
+	logger     *slog.Logger
+}
+
+func NewService(repository *Repository, movies MovieLookup, eventBus *shared.EventBus, logger *slog.Logger) *Service {
+	return &Service{
+		repository: repository,
+		movies:     movies,
+		eventBus:   eventBus,
+		logger:     logger.With(slog.String("domain", "people")),
+	}
+}
+
+func (s *Service) CreatePerson(ctx context.Context, person *Person) (*Person, error) {
+	if person == nil {
+		return nil, fmt.Errorf("person cannot be nil")
+	}
+	person.normalize()
+	if fields := person.validate(); len(fields) > 0 {
+		return nil, shared.NewValidationError("invalid person", fields)
+	}
+	if err := shared.Authorize(ctx, shared.ActionWriteCatalog, ""); err != nil {
+		return nil, err
+	}
+
+	created, err := s.repository.CreatePerson(ctx, person)
+	if err != nil {
+		return nil, err
+	}
+
+	event := NewPersonCreatedEvent(created.ID.Hex(), created.Name)
+
+	if err := s.eventBus.Publish(ctx, event); err != nil {
+		s.logger.WarnContext(ctx, "failed to publish person created event", slog.Any("error", err))
+	}
+
+	return created, nil
+}
+
+func (s *Service) GetPerson(ctx context.Context, id string) (*Person, error) {
+	if id == "" {
+		return nil, shared.NewFieldError("person_id", "must not be empty")
+	}
+
+	return s.repository.GetPersonByID(ctx, id)
+}
+
+// UpdatePerson replaces the details of a person, keeping their credits.
+func (s *Service) UpdatePerson(ctx context.Context, person *Person) (*Person, error) {
+	if person == nil {
+		return nil, fmt.Errorf("person cannot be nil")
+	}
+	person.normalize()
+	if fields := person.validate(); len(fields) > 0 {
+		return nil, shared.NewValidationError("invalid person", fields)
+	}
+	if err := shared.Authorize(ctx, shared.ActionWriteCatalog, ""); err != nil {
+		return nil, err
+	}
+
+	return s.repository.UpdatePerson(ctx, person)
+}
+
+// FindPeople returns the people going by the name, ignoring case and
+// accents.
+func (s *Service) FindPeople(ctx context.Context, name string, limit int) ([]*Person, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, shared.NewFieldError("name", "must not be empty")
+	}
+	if limit == 0 {
+		limit = DefaultPeopleLimit
+	}
+	if limit < 0 || limit > MaxPeopleLimit {
+		return nil, shared.NewFieldError("limit", fmt.Sprintf("must be between 1 and %d", MaxPeopleLimit))
+	}
+
+	return s.repository.FindPeopleByName(ctx, name, limit)
+}
+
+// SetMovieCredits replaces the credits of a movie with the given ones, in
+// billing order. A person may appear more than once, such as an actor who
+// also directed, but not twice in the same role and character.
+func (s *Service) SetMovieCredits(ctx context.Context, movieID string, inputs []CreditInput) (*MovieCredits, error) {
+	if movieID == "" {
+		return nil, shared.NewFieldError("movie_id", "must not be empty")
+	}
+	credits, fields := parseCredits(inputs)
+	if len(fields) > 0 {
+		return nil, shared.NewValidationError("invalid credits", fields)
+	}
+	if err := shared.Authorize(ctx, shared.ActionWriteCatalog, ""); err != nil {
+		return nil, err
+	}
+
+	movie, err := s.movies.GetMovieByID(ctx, movieID)
+	if err != nil {
+		return nil, err
+	}
+
+	people, err := s.repository.GetPeopleByIDs(ctx, creditedPeople(credits))
+	if err != nil {
+		return nil, err
+	}
+	for i, credit := range credits {
+		if _, ok := people[credit.PersonID]; !ok {
+			fields[fmt.Sprintf("credits[%d].person_id", i)] = "must be an existing person"
+		}
+	}
+	if len(fields) > 0 {
+		return nil, shared.NewValidationError("invalid credits", fields)
+	}
+
+	previous, err := s.repository.GetMovieCredits(ctx, movie.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repository.ReplaceMovieCredits(ctx, movie.ID, credits); err != nil {
+		return nil, err
+	}
+
+	added, removed := diffPeople(creditedPeople(previous), creditedPeople(credits))
+	event := NewMovieCreditsChangedEvent(movie.ID.Hex(), movie.Title, added, removed)
+
+	if err := s.eventBus.Publish(ctx, event); err != nil {
+		s.logger.WarnContext(ctx, "failed to publish movie credits changed event", slog.Any("error", err))
+	}
+
+	return movieCredits(movie.ID.Hex(), credits, people), nil
+}
+
+// parseCredits turns the credits as given into those to store, with the
+// problems by field.
+func parseCredits(inputs []CreditInput) ([]*Credit, map[string]string) {
+	fields := make(map[string]string)
+	if len(inputs) > MaxCredits {
+		fields["credits"] = fmt.Sprintf("must have at most %d credits", MaxCredits)
+		return nil, fields
+	}
+
+	credits := make([]*Credit, 0, len(inputs))
+	seen := make(map[string]bool, len(inputs))
+	for i, input := range inputs {
+		personID, err := primitive.ObjectIDFromHex(input.PersonID)
+		if err != nil {
+			fields[fmt.Sprintf("credits[%d].person_id", i)] = "must be a 24 character hex ObjectID"
+			continue
+		}
+		if !slices.Contains(Roles, input.Role) {
+			fields[fmt.Sprintf("credits[%d].role", i)] = fmt.Sprintf("must be one of %v", Roles)
+			continue
+		}
+		character := strings.TrimSpace(input.Character)
+		if character != "" && input.Role != RoleActor {
+			fields[fmt.Sprintf("credits[%d].character", i)] = "is only for actors"
+			continue
+		}
+
+		credit := &Credit{PersonID: personID, Role: input.Role, Character: character, Order: i}
+		if seen[credit.key()] {
+			fields[fmt.Sprintf("credits[%d]", i)] = "repeats an earlier credit"
+			continue
+		}
+		seen[credit.key()] = true
+		credits = append(credits, credit)
+	}
+
+	return credits, fields
+}
+
+// GetMovieCredits returns the cast and crew of a movie.
+func (s *Service) GetMovieCredits(ctx context.Context, movieID string) (*MovieCredits, error) {
+	movie, err := s.movies.GetMovieByID(ctx, movieID)
+	if err != nil {
+		return nil, err
+	}
+
+	credits, err := s.repository.GetMovieCredits(ctx, movie.ID)
+	if err != nil {
+		return nil, err
+	}
+	people, err := s.repository.GetPeopleByIDs(ctx, creditedPeople(credits))
+	if err != nil {
+		return nil, err
+	}
+
+	return movieCredits(movie.ID.Hex(), credits, people), nil
+}
+
+// GetFilmography returns the movies a person worked on, newest release
+// first. Credits of movies no longer in the catalog are left out.
+func (s *Service) GetFilmography(ctx context.Context, personID string) (*Filmography, error) {
+	person, err := s.GetPerson(ctx, personID)
+	if err != nil {
+		return nil, err
+	}
+
+	credits, err := s.repository.GetPersonCredits(ctx, person.ID)
+	if err != nil {
+		return nil, err
+	}
+	movieIDs := make([]string, 0, len(credits))
+	for _, credit := range credits {
+		if !slices.Contains(movieIDs, credit.MovieID.Hex()) {
+			movieIDs = append(movieIDs, credit.MovieID.Hex())
+		}
+	}
+	movies, err := s.movies.GetMoviesByIDs(ctx, movieIDs)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[primitive.ObjectID]*schema.Movie, len(movies))
+	for _, movie := range movies {
+		byID[movie.ID] = movie
+	}
+
+	entries := make([]*FilmographyEntry, 0, len(credits))
+	for _, credit := range credits {
+		movie, ok := byID[credit.MovieID]
+		if !ok {
+			continue
+		}
+		entries = append(entries, &FilmographyEntry{Movie: movie, Role: credit.Role, Character: credit.Character})
+	}
+	// Release dates are YYYY-MM-DD, so they sort as strings
+	slices.SortStableFunc(entries, func(a, b *FilmographyEntry) int {
+		return strings.Compare(b.Movie.ReleaseDate, a.Movie.ReleaseDate)
+	})
+
+	return &Filmography{Person: person, Credits: entries}, nil
+}
+
+// movieCredits splits the credits of a movie into cast and crew, leaving out
+// people who no longer exist.
+func movieCredits(movieID string, credits []*Credit, people map[primitive.ObjectID]*Person) *MovieCredits {
+	result := &MovieCredits{MovieID: movieID, Cast: []*CreditedPerson{}, Crew: []*CreditedPerson{}}
+	for _, credit := range credits {
+		person, ok := people[credit.PersonID]
+		if !ok {
+			continue
+		}
+		credited := &CreditedPerson{Person: person, Role: credit.Role, Character: credit.Character}
+		if credit.Role == RoleActor {
+			result.Cast = append(result.Cast, credited)
+		} else {
+			result.Crew = append(result.Crew, credited)
+		}
+	}
+	return result
+}
+
+// creditedPeople returns the distinct people of the credits.
+func creditedPeople(credits []*Credit) []primitive.ObjectID {
+	ids := make([]primitive.ObjectID, 0, len(credits))
+	for _, credit := range credits {
+		if !slices.Contains(ids, credit.PersonID) {
+			ids = append(ids, credit.PersonID)
+		}
+	}
+	return ids
+}
+
+// diffPeople returns the people in after but not before, and those in
+// before but not after.
+func diffPeople(before, after []primitive.ObjectID) (added, removed []string) {
+	added, removed = []string{}, []string{}
+	for _, id := range after {
+		if !slices.Contains(before, id) {
+			added = append(added, id.Hex())
+		}
+	}
+	for _, id := range before {
+		if !slices.Contains(after, id) {
+			removed = append(removed, id.Hex())
+		}
+	}
+	return added, removed
+}
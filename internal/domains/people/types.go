@@ -0,0 +1,151 @@
+package people
+
+import (
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"event-driven-go/internal/shared"
+	schema "github.com/nameteos/my-movies-db-schema/mongodb"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var (
+	ErrPersonNotFound      = shared.NewNotFoundError("person")
+	ErrInvalidPersonID     = shared.NewFieldError("id", "must be a 24 character hex ObjectID")
+	ErrDuplicateExternalID = shared.NewAlreadyExistsError("another person has the same external ID")
+)
+
+var (
+	imdbNameIDPattern = regexp.MustCompile(`^nm[0-9]{7,}$`)
+	wikidataIDPattern = regexp.MustCompile(`^Q[1-9][0-9]*$`)
+)
+
+// Person is someone credited for work on movies, in front of or behind the
+// camera.
+type Person struct {
+	ID      primitive.ObjectID `json:"id" bson:"_id,omitempty"`
+	Name    string             `json:"name" bson:"name"`
+	Aliases []string           `json:"aliases" bson:"aliases"`
+	// BirthDate is a YYYY-MM-DD date.
+	BirthDate   string      `json:"birth_date,omitempty" bson:"birth_date,omitempty"`
+	ExternalIDs ExternalIDs `json:"external_ids" bson:"external_ids"`
+	CreatedAt   time.Time   `json:"created_at" bson:"created_at"`
+	UpdatedAt   time.Time   `json:"updated_at" bson:"updated_at"`
+}
+
+// ExternalIDs are the IDs of a person in other catalogs.
+type ExternalIDs struct {
+	IMDb     string `json:"imdb,omitempty" bson:"imdb,omitempty"`
+	TMDb     int    `json:"tmdb,omitempty" bson:"tmdb,omitempty"`
+	Wikidata string `json:"wikidata,omitempty" bson:"wikidata,omitempty"`
+}
+
+// normalize trims the fields of the person and drops empty and repeated
+// aliases, as well as those equal to the name.
+func (p *Person) normalize() {
+	p.Name = strings.TrimSpace(p.Name)
+	p.BirthDate = strings.TrimSpace(p.BirthDate)
+
+	aliases := make([]string, 0, len(p.Aliases))
+	for _, alias := range p.Aliases {
+		alias = strings.TrimSpace(alias)
+		if alias != "" && alias != p.Name && !slices.Contains(aliases, alias) {
+			aliases = append(aliases, alias)
+		}
+	}
+	p.Aliases = aliases
+}
+
+func (p *Person) validate() map[string]string {
+	fields := make(map[string]string)
+
+	if p.Name == "" {
+		fields["name"] = "must not be empty"
+	}
+	if p.BirthDate != "" {
+		if _, err := time.Parse(time.DateOnly, p.BirthDate); err != nil {
+			fields["birth_date"] = "must be a date such as 1964-09-02"
+		}
+	}
+	if p.ExternalIDs.IMDb != "" && !imdbNameIDPattern.MatchString(p.ExternalIDs.IMDb) {
+		fields["external_ids.imdb"] = "must be an IMDb name ID such as nm0000206"
+	}
+	if p.ExternalIDs.TMDb < 0 {
+		fields["external_ids.tmdb"] = "must not be negative"
+	}
+	if p.ExternalIDs.Wikidata != "" && !wikidataIDPattern.MatchString(p.ExternalIDs.Wikidata) {
+		fields["external_ids.wikidata"] = "must be a Wikidata item ID such as Q43416"
+	}
+
+	return fields
+}
+
+// Role is the part a person had in making a movie.
+type Role string
+
+const (
+	RoleActor           Role = "actor"
+	RoleDirector        Role = "director"
+	RoleWriter          Role = "writer"
+	RoleProducer        Role = "producer"
+	RoleComposer        Role = "composer"
+	RoleCinematographer Role = "cinematographer"
+	RoleEditor          Role = "editor"
+)
+
+var Roles = []Role{RoleActor, RoleDirector, RoleWriter, RoleProducer, RoleComposer, RoleCinematographer, RoleEditor}
+
+// Credit links a person to a movie they worked on. Order is the billing
+// position among the credits of the movie.
+type Credit struct {
+	ID       primitive.ObjectID `bson:"_id,omitempty"`
+	MovieID  primitive.ObjectID `bson:"movie_id"`
+	PersonID primitive.ObjectID `bson:"person_id"`
+	Role     Role               `bson:"role"`
+	// Character is the part an actor played.
+	Character string `bson:"character,omitempty"`
+	Order     int    `bson:"order"`
+}
+
+// key identifies a credit within the credits of a movie.
+func (c *Credit) key() string {
+	return c.PersonID.Hex() + "/" + string(c.Role) + "/" + c.Character
+}
+
+// CreditInput is a credit as given for a movie, whose position in the list
+// is its billing order.
+type CreditInput struct {
+	PersonID  string `json:"person_id" openapi:"required"`
+	Role      Role   `json:"role" openapi:"required"`
+	Character string `json:"character,omitempty"`
+}
+
+// CreditedPerson is a person in the credits of a movie.
+type CreditedPerson struct {
+	Person    *Person `json:"person"`
+	Role      Role    `json:"role"`
+	Character string  `json:"character,omitempty"`
+}
+
+// MovieCredits are the people who made a movie in billing order, the actors
+// in Cast and everyone else in Crew.
+type MovieCredits struct {
+	MovieID string            `json:"movie_id"`
+	Cast    []*CreditedPerson `json:"cast"`
+	Crew    []*CreditedPerson `json:"crew"`
+}
+
+// FilmographyEntry is a movie a person worked on, once per role.
+type FilmographyEntry struct {
+	Movie     *schema.Movie `json:"movie"`
+	Role      Role          `json:"role"`
+	Character string        `json:"character,omitempty"`
+}
+
+// Filmography lists the movies of a person, newest release first.
+type Filmography struct {
+	Person  *Person             `json:"person"`
+	Credits []*FilmographyEntry `json:"credits"`
+}
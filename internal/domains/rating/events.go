@@ -19,8 +19,9 @@ type MovieRatedEvent struct {
 }
 
 func init() {
-	shared.GlobalEventBus.RegisterEventType(MovieRatedEventType, &MovieRatedEvent{}, &Handler{})
-	shared.GlobalEventBus.RegisterEventType(MovieUnratedEventType, &MovieUnratedEvent{}, &Handler{})
+	handler := NewHandler(shared.Logger)
+	shared.GlobalEventBus.RegisterEventType(MovieRatedEventType, &MovieRatedEvent{}, handler)
+	shared.GlobalEventBus.RegisterEventType(MovieUnratedEventType, &MovieUnratedEvent{}, handler)
 }
 
 func (e MovieRatedEvent) GetPayload() interface{} {
@@ -0,0 +1,183 @@
+package rating
+
+import (
+	"context"
+	"log/slog"
+
+	moviesdbv1 "event-driven-go/internal/gen/moviesdb/v1"
+	"event-driven-go/internal/shared"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GRPCServer implements moviesdb.v1.RatingService on top of the service.
+type GRPCServer struct {
+	moviesdbv1.UnimplementedRatingServiceServer
+	service *Service
+	logger  *slog.Logger
+}
+
+func NewGRPCServer(service *Service, logger *slog.Logger) *GRPCServer {
+	return &GRPCServer{
+		service: service,
+		logger:  logger.With(slog.String("domain", "rating")),
+	}
+}
+
+func (s *GRPCServer) Register(server *grpc.Server) {
+	moviesdbv1.RegisterRatingServiceServer(server, s)
+}
+
+func (s *GRPCServer) RateMovie(ctx context.Context, req *moviesdbv1.RateMovieRequest) (*moviesdbv1.Rating, error) {
+	if _, err := shared.AuthenticatedUserID(ctx); err != nil {
+		return nil, err
+	}
+
+	fields := make(map[string]string)
+	if req.Rating == nil || req.GetRating() < 0 || req.GetRating() > 5 {
+		fields["rating"] = "must be a number between 0 and 5"
+	}
+	if len(req.GetReview()) > maxReviewLength {
+		fields["review"] = "must be at most 5000 characters"
+	}
+	if len(fields) > 0 {
+		return nil, shared.NewValidationError("invalid rating", fields)
+	}
+
+	rating, err := s.service.RateMovie(ctx, req.GetUserId(), req.GetMovieId(), req.GetRating(), req.GetReview())
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoRating(rating), nil
+}
+
+func (s *GRPCServer) RemoveRating(ctx context.Context, req *moviesdbv1.RemoveRatingRequest) (*emptypb.Empty, error) {
+	if _, err := shared.AuthenticatedUserID(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.service.RemoveRating(ctx, req.GetUserId(), req.GetMovieId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *GRPCServer) ListUserRatings(ctx context.Context, req *moviesdbv1.ListUserRatingsRequest) (*moviesdbv1.ListRatingsResponse, error) {
+	if _, err := shared.AuthenticatedUserID(ctx); err != nil {
+		return nil, err
+	}
+
+	page, err := shared.ValidatePageRequest(req.GetLimit(), req.GetOffset(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	ratings, err := s.service.GetUserRatings(ctx, req.GetUserId(), page)
+	if err != nil {
+		return nil, err
+	}
+
+	return &moviesdbv1.ListRatingsResponse{
+		Ratings:    toProtoRatings(ratings.Items),
+		NextCursor: ratings.NextCursor,
+		PrevCursor: ratings.PrevCursor,
+	}, nil
+}
+
+func (s *GRPCServer) ListMovieRatings(ctx context.Context, req *moviesdbv1.ListMovieRatingsRequest) (*moviesdbv1.ListMovieRatingsResponse, error) {
+	if err := validateMovieID(req.GetMovieId()); err != nil {
+		return nil, err
+	}
+	page, err := shared.ValidatePageRequest(req.GetLimit(), req.GetOffset(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	ratings, err := s.service.GetMovieRatings(ctx, req.GetMovieId(), page)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := s.service.GetMovieRatingSummary(ctx, req.GetMovieId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &moviesdbv1.ListMovieRatingsResponse{
+		Ratings:    toProtoRatings(ratings.Items),
+		Summary:    toProtoSummary(summary),
+		NextCursor: ratings.NextCursor,
+		PrevCursor: ratings.PrevCursor,
+	}, nil
+}
+
+func (s *GRPCServer) GetRatingDistribution(ctx context.Context, req *moviesdbv1.GetRatingDistributionRequest) (*moviesdbv1.GetRatingDistributionResponse, error) {
+	if err := validateMovieID(req.GetMovieId()); err != nil {
+		return nil, err
+	}
+
+	distribution, err := s.service.GetRatingDistribution(ctx, req.GetMovieId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &moviesdbv1.GetRatingDistributionResponse{Distribution: distribution}, nil
+}
+
+func (s *GRPCServer) ListTopRatedMovies(ctx context.Context, req *moviesdbv1.ListTopRatedMoviesRequest) (*moviesdbv1.ListTopRatedMoviesResponse, error) {
+	limit, _, err := shared.ValidatePagination(req.GetLimit(), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	topRated, err := s.service.GetTopRatedMovies(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &moviesdbv1.ListTopRatedMoviesResponse{Movies: make([]*moviesdbv1.RatingSummary, 0, len(topRated))}
+	for _, summary := range topRated {
+		response.Movies = append(response.Movies, toProtoSummary(summary))
+	}
+
+	return response, nil
+}
+
+// validateMovieID rejects IDs the REST API would refuse as path parameters.
+func validateMovieID(movieID string) error {
+	if !primitive.IsValidObjectID(movieID) {
+		return shared.NewFieldError("movie_id", "must be a 24 character hex ObjectID")
+	}
+	return nil
+}
+
+func toProtoRatings(ratings []*MovieRating) []*moviesdbv1.Rating {
+	converted := make([]*moviesdbv1.Rating, 0, len(ratings))
+	for _, rating := range ratings {
+		converted = append(converted, toProtoRating(rating))
+	}
+	return converted
+}
+
+func toProtoRating(rating *MovieRating) *moviesdbv1.Rating {
+	return &moviesdbv1.Rating{
+		Id:        rating.ID,
+		UserId:    rating.UserID,
+		MovieId:   rating.MovieID,
+		Rating:    rating.Rating,
+		Review:    rating.Review,
+		CreatedAt: timestamppb.New(rating.CreatedAt),
+		UpdatedAt: timestamppb.New(rating.UpdatedAt),
+	}
+}
+
+func toProtoSummary(summary *RatingSummary) *moviesdbv1.RatingSummary {
+	return &moviesdbv1.RatingSummary{
+		MovieId:   summary.MovieID,
+		AvgRating: summary.AvgRating,
+		Count:     summary.Count,
+	}
+}
@@ -3,12 +3,20 @@ package rating
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
 
 	"event-driven-go/internal/shared"
 )
 
-type Handler struct{}
+type Handler struct {
+	logger *slog.Logger
+}
+
+func NewHandler(logger *slog.Logger) *Handler {
+	return &Handler{
+		logger: logger.With(slog.String("domain", "rating")),
+	}
+}
 
 func (h *Handler) Handle(ctx context.Context, event shared.Event) error {
 	switch e := event.(type) {
@@ -26,27 +34,30 @@ func (h *Handler) CanHandle(eventType string) bool {
 }
 
 func (h *Handler) handleMovieRated(ctx context.Context, event *MovieRatedEvent) error {
-	reviewText := ""
-	if event.Review != "" {
-		reviewText = fmt.Sprintf(" with review: \"%s\"", event.Review)
-	}
-
-	log.Printf("⭐ RATING: User %s rated '%s' %.1f/5%s",
-		event.UserID, event.Title, event.Rating, reviewText)
+	h.logger.InfoContext(ctx, "user rated movie",
+		slog.String("user_id", event.UserID),
+		slog.String("movie_id", event.MovieID),
+		slog.String("title", event.Title),
+		slog.Float64("rating", event.Rating),
+		slog.Bool("has_review", event.Review != ""),
+	)
 
 	// todo service
 
-	log.Printf("✅ RATING: Successfully processed rating event %s", event.GetID())
+	h.logger.DebugContext(ctx, "successfully processed rating event")
 	return nil
 }
 
 // handleMovieUnrated processes MovieUnratedEvent
 func (h *Handler) handleMovieUnrated(ctx context.Context, event *MovieUnratedEvent) error {
-	log.Printf("❌ RATING: User %s removed rating for '%s'",
-		event.UserID, event.Title)
+	h.logger.InfoContext(ctx, "user removed rating",
+		slog.String("user_id", event.UserID),
+		slog.String("movie_id", event.MovieID),
+		slog.String("title", event.Title),
+	)
 
 	// todo service
 
-	log.Printf("✅ RATING: Successfully processed unrating event %s", event.GetID())
+	h.logger.DebugContext(ctx, "successfully processed unrating event")
 	return nil
 }
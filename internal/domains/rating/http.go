@@ -0,0 +1,233 @@
+package rating
+
+import (
+	"log/slog"
+	"net/http"
+
+	"event-driven-go/internal/shared"
+)
+
+const maxReviewLength = 5000
+
+type HTTPHandler struct {
+	service *Service
+	logger  *slog.Logger
+}
+
+func NewHTTPHandler(service *Service, logger *slog.Logger) *HTTPHandler {
+	return &HTTPHandler{
+		service: service,
+		logger:  logger.With(slog.String("domain", "rating")),
+	}
+}
+
+func (h *HTTPHandler) RegisterRoutes(router *shared.Router) {
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/users/{id}/ratings",
+		Tag:      "ratings",
+		Summary:  "Ratings given by a user",
+		Query:    shared.PaginationParams,
+		Response: RatingListResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound},
+		Auth:     true,
+	}, h.getUserRatings)
+	router.Handle(shared.Route{
+		Method:   http.MethodPut,
+		Path:     "/users/{id}/ratings/{movieID}",
+		Tag:      "ratings",
+		Summary:  "Rate a movie from 0 to 5",
+		Request:  RateMovieRequest{},
+		Response: MovieRating{},
+		Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusUnprocessableEntity},
+		Auth:     true,
+	}, h.rateMovie)
+	router.Handle(shared.Route{
+		Method:  http.MethodDelete,
+		Path:    "/users/{id}/ratings/{movieID}",
+		Tag:     "ratings",
+		Summary: "Remove a rating; curators and admins may remove anyone's",
+		Status:  http.StatusNoContent,
+		Errors:  []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound},
+		Auth:    true,
+	}, h.removeRating)
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/movies/{id}/ratings",
+		Tag:      "ratings",
+		Summary:  "Ratings of a movie with their average",
+		Query:    shared.PaginationParams,
+		Response: MovieRatingsResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+	}, h.getMovieRatings)
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/movies/{id}/ratings/distribution",
+		Tag:      "ratings",
+		Summary:  "Number of ratings per star",
+		Response: RatingDistributionResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusNotFound},
+	}, h.getRatingDistribution)
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/ratings/top",
+		Tag:      "ratings",
+		Summary:  "Best rated movies with at least three ratings",
+		Query:    []shared.QueryParam{shared.LimitParam},
+		Response: TopRatedResponse{},
+		Errors:   []int{http.StatusBadRequest},
+	}, h.getTopRated)
+}
+
+type RateMovieRequest struct {
+	Rating *float64 `json:"rating" openapi:"required"`
+	Review string   `json:"review,omitempty"`
+}
+
+type RatingListResponse struct {
+	Data       []*MovieRating    `json:"data"`
+	Pagination shared.Pagination `json:"pagination"`
+}
+
+// MovieRatingsResponse is a page of a movie's ratings together with the
+// average over all of them.
+type MovieRatingsResponse struct {
+	Data       []*MovieRating    `json:"data"`
+	Summary    *RatingSummary    `json:"summary"`
+	Pagination shared.Pagination `json:"pagination"`
+}
+
+type RatingDistributionResponse struct {
+	MovieID      string           `json:"movie_id"`
+	Distribution map[string]int64 `json:"distribution"`
+}
+
+type TopRatedResponse struct {
+	Data []*RatingSummary `json:"data"`
+}
+
+func (h *HTTPHandler) getUserRatings(w http.ResponseWriter, r *http.Request) {
+	page, ok := shared.ParsePageRequest(w, r)
+	if !ok {
+		return
+	}
+
+	ratings, err := h.service.GetUserRatings(r.Context(), r.PathValue("id"), page)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, RatingListResponse{
+		Data:       ratings.Items,
+		Pagination: shared.PaginationOf(ratings, page),
+	})
+}
+
+func (h *HTTPHandler) rateMovie(w http.ResponseWriter, r *http.Request) {
+	movieID, ok := shared.PathObjectID(w, r, "movieID")
+	if !ok {
+		return
+	}
+
+	var request RateMovieRequest
+	if err := shared.DecodeJSON(w, r, &request); err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	fields := make(map[string]string)
+	if request.Rating == nil || *request.Rating < 0 || *request.Rating > 5 {
+		fields["rating"] = "must be a number between 0 and 5"
+	}
+	if len(request.Review) > maxReviewLength {
+		fields["review"] = "must be at most 5000 characters"
+	}
+	if len(fields) > 0 {
+		shared.WriteError(w, r, http.StatusUnprocessableEntity, "invalid rating", fields)
+		return
+	}
+
+	rating, err := h.service.RateMovie(r.Context(), r.PathValue("id"), movieID, *request.Rating, request.Review)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, rating)
+}
+
+func (h *HTTPHandler) removeRating(w http.ResponseWriter, r *http.Request) {
+	movieID, ok := shared.PathObjectID(w, r, "movieID")
+	if !ok {
+		return
+	}
+
+	if err := h.service.RemoveRating(r.Context(), r.PathValue("id"), movieID); err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *HTTPHandler) getMovieRatings(w http.ResponseWriter, r *http.Request) {
+	movieID, ok := shared.PathObjectID(w, r, "id")
+	if !ok {
+		return
+	}
+	page, ok := shared.ParsePageRequest(w, r)
+	if !ok {
+		return
+	}
+
+	ratings, err := h.service.GetMovieRatings(r.Context(), movieID, page)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+	summary, err := h.service.GetMovieRatingSummary(r.Context(), movieID)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, MovieRatingsResponse{
+		Data:       ratings.Items,
+		Summary:    summary,
+		Pagination: shared.PaginationOf(ratings, page),
+	})
+}
+
+func (h *HTTPHandler) getRatingDistribution(w http.ResponseWriter, r *http.Request) {
+	movieID, ok := shared.PathObjectID(w, r, "id")
+	if !ok {
+		return
+	}
+
+	distribution, err := h.service.GetRatingDistribution(r.Context(), movieID)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, RatingDistributionResponse{MovieID: movieID, Distribution: distribution})
+}
+
+func (h *HTTPHandler) getTopRated(w http.ResponseWriter, r *http.Request) {
+	limit, _, ok := shared.ParsePagination(w, r)
+	if !ok {
+		return
+	}
+
+	topRated, err := h.service.GetTopRatedMovies(r.Context(), limit)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+	if topRated == nil {
+		topRated = []*RatingSummary{}
+	}
+
+	shared.WriteJSON(w, http.StatusOK, TopRatedResponse{Data: topRated})
+}
@@ -2,18 +2,27 @@ package rating
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"time"
 
+	"event-driven-go/internal/shared"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Repository struct {
-	db *gorm.DB
+	db     *gorm.DB
+	logger *slog.Logger
 }
 
-func NewRepository(db *gorm.DB) *Repository {
-	return &Repository{db: db}
+func NewRepository(db *gorm.DB, logger *slog.Logger) *Repository {
+	return &Repository{
+		db:     db,
+		logger: logger.With(slog.String("repository", "rating")),
+	}
 }
 
 func (r *Repository) AddRating(ctx context.Context, userID, movieID string, rating float64, review string) (*MovieRating, error) {
@@ -29,6 +38,10 @@ func (r *Repository) AddRating(ctx context.Context, userID, movieID string, rati
 	if result.Error != nil {
 		return nil, fmt.Errorf("failed to add rating: %w", result.Error)
 	}
+	r.logger.DebugContext(ctx, "rating added",
+		slog.String("user_id", userID),
+		slog.String("movie_id", movieID),
+	)
 
 	return movieRating, nil
 }
@@ -42,8 +55,8 @@ func (r *Repository) UpdateRating(ctx context.Context, userID, movieID string, r
 		First(&movieRating)
 
 	if result.Error != nil {
-		if result.Error == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("rating not found")
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrRatingNotFound
 		}
 		return nil, fmt.Errorf("failed to find rating: %w", result.Error)
 	}
@@ -59,23 +72,39 @@ func (r *Repository) UpdateRating(ctx context.Context, userID, movieID string, r
 	return &movieRating, nil
 }
 
+// UpsertRating creates the user's rating of a movie or overwrites the existing one.
 func (r *Repository) UpsertRating(ctx context.Context, userID, movieID string, rating float64, review string) (*MovieRating, error) {
-	var movieRating MovieRating
+	movieRating := &MovieRating{
+		ID:        uuid.New().String(),
+		UserID:    userID,
+		MovieID:   movieID,
+		Rating:    rating,
+		Review:    review,
+		UpdatedAt: time.Now(),
+	}
 
-	// Try to find existing rating
+	// A single statement on idx_movie_ratings_user_movie keeps concurrent
+	// ratings of the same movie from racing between a lookup and an insert
 	result := r.db.WithContext(ctx).
-		Where("user_id = ? AND movie_id = ?", userID, movieID).
-		First(&movieRating)
+		Clauses(
+			clause.OnConflict{
+				Columns:     []clause.Column{{Name: "user_id"}, {Name: "movie_id"}},
+				TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "deleted_at IS NULL"}}},
+				DoUpdates:   clause.AssignmentColumns([]string{"rating", "review", "updated_at"}),
+			},
+			clause.Returning{},
+		).
+		Create(movieRating)
 
-	if result.Error == gorm.ErrRecordNotFound {
-		// Create new rating
-		return r.AddRating(ctx, userID, movieID, rating, review)
-	} else if result.Error != nil {
-		return nil, fmt.Errorf("failed to check existing rating: %w", result.Error)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to upsert rating: %w", result.Error)
 	}
+	r.logger.DebugContext(ctx, "rating upserted",
+		slog.String("user_id", userID),
+		slog.String("movie_id", movieID),
+	)
 
-	// Update existing rating
-	return r.UpdateRating(ctx, userID, movieID, rating, review)
+	return movieRating, nil
 }
 
 func (r *Repository) RemoveRating(ctx context.Context, userID, movieID string) error {
@@ -88,7 +117,7 @@ func (r *Repository) RemoveRating(ctx context.Context, userID, movieID string) e
 	}
 
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("rating not found")
+		return ErrRatingNotFound
 	}
 
 	return nil
@@ -102,8 +131,8 @@ func (r *Repository) GetUserRating(ctx context.Context, userID, movieID string)
 		First(&rating)
 
 	if result.Error != nil {
-		if result.Error == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("rating not found")
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrRatingNotFound
 		}
 		return nil, fmt.Errorf("failed to get user rating: %w", result.Error)
 	}
@@ -111,23 +140,35 @@ func (r *Repository) GetUserRating(ctx context.Context, userID, movieID string)
 	return &rating, nil
 }
 
-func (r *Repository) GetMovieRatings(ctx context.Context, movieID string, limit, offset int) ([]*MovieRating, error) {
+// GetUserRatingsForMovies returns the ratings a user gave to any of the movies.
+func (r *Repository) GetUserRatingsForMovies(ctx context.Context, userID string, movieIDs []string) ([]*MovieRating, error) {
 	var ratings []*MovieRating
 
 	result := r.db.WithContext(ctx).
-		Where("movie_id = ?", movieID).
-		Order("created_at DESC").
-		Limit(limit).
-		Offset(offset).
+		Where("user_id = ? AND movie_id IN ?", userID, movieIDs).
 		Find(&ratings)
 
 	if result.Error != nil {
-		return nil, fmt.Errorf("failed to get movie ratings: %w", result.Error)
+		return nil, fmt.Errorf("failed to get user ratings: %w", result.Error)
 	}
 
 	return ratings, nil
 }
 
+// ratingCursor is the sort key of rating lists, newest first.
+type ratingCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        string    `json:"id"`
+}
+
+func ratingCursorOf(rating *MovieRating) interface{} {
+	return ratingCursor{CreatedAt: rating.CreatedAt, ID: rating.ID}
+}
+
+func (r *Repository) GetMovieRatings(ctx context.Context, movieID string, page shared.PageRequest) (*shared.Page[*MovieRating], error) {
+	return r.listRatings(ctx, r.db.WithContext(ctx).Where("movie_id = ?", movieID), page)
+}
+
 func (r *Repository) GetMovieAverageRating(ctx context.Context, movieID string) (float64, int, error) {
 	var result struct {
 		AvgRating float64
@@ -147,34 +188,50 @@ func (r *Repository) GetMovieAverageRating(ctx context.Context, movieID string)
 	return result.AvgRating, int(result.Count), nil
 }
 
-func (r *Repository) GetUserRatings(ctx context.Context, userID string, limit, offset int) ([]*MovieRating, error) {
-	var ratings []*MovieRating
+func (r *Repository) GetUserRatings(ctx context.Context, userID string, page shared.PageRequest) (*shared.Page[*MovieRating], error) {
+	return r.listRatings(ctx, r.db.WithContext(ctx).Where("user_id = ?", userID), page)
+}
 
-	result := r.db.WithContext(ctx).
-		Where("user_id = ?", userID).
-		Order("created_at DESC").
-		Limit(limit).
-		Offset(offset).
+func (r *Repository) listRatings(ctx context.Context, query *gorm.DB, page shared.PageRequest) (*shared.Page[*MovieRating], error) {
+	var key ratingCursor
+	direction, err := page.Seek(&key)
+	if err != nil {
+		return nil, err
+	}
+
+	var ratings []*MovieRating
+	result := shared.KeysetPage(query, page, direction, []string{"created_at", "id"}, key.CreatedAt, key.ID).
 		Find(&ratings)
 
 	if result.Error != nil {
-		return nil, fmt.Errorf("failed to get user ratings: %w", result.Error)
+		return nil, fmt.Errorf("failed to list ratings: %w", result.Error)
 	}
 
-	return ratings, nil
+	return shared.NewPage(ratings, page, direction, ratingCursorOf), nil
 }
 
-func (r *Repository) GetTopRatedMovies(ctx context.Context, limit int) ([]struct {
-	MovieID   string  `json:"movie_id"`
-	AvgRating float64 `json:"avg_rating"`
-	Count     int64   `json:"count"`
-}, error) {
-	var results []struct {
-		MovieID   string  `json:"movie_id"`
-		AvgRating float64 `json:"avg_rating"`
-		Count     int64   `json:"count"`
+// GetRatingSummaries averages the ratings of several movies in one query.
+// Movies without ratings are left out.
+func (r *Repository) GetRatingSummaries(ctx context.Context, movieIDs []string) ([]*RatingSummary, error) {
+	var results []*RatingSummary
+
+	err := r.db.WithContext(ctx).
+		Model(&MovieRating{}).
+		Select("movie_id, AVG(rating) as avg_rating, COUNT(*) as count").
+		Where("movie_id IN ?", movieIDs).
+		Group("movie_id").
+		Scan(&results).Error
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get rating summaries: %w", err)
 	}
 
+	return results, nil
+}
+
+func (r *Repository) GetTopRatedMovies(ctx context.Context, limit int) ([]*RatingSummary, error) {
+	var results []*RatingSummary
+
 	err := r.db.WithContext(ctx).
 		Model(&MovieRating{}).
 		Select("movie_id, AVG(rating) as avg_rating, COUNT(*) as count").
@@ -217,6 +274,47 @@ func (r *Repository) GetRatingDistribution(ctx context.Context, movieID string)
 	return distribution, nil
 }
 
-func (r *Repository) AutoMigrate() error {
-	return r.db.AutoMigrate(&MovieRating{})
+// DeleteUserData removes all ratings belonging to a user.
+func (r *Repository) DeleteUserData(ctx context.Context, userID string) error {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&MovieRating{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete user ratings: %w", result.Error)
+	}
+	r.logger.DebugContext(ctx, "user ratings deleted",
+		slog.String("user_id", userID),
+		slog.Int64("rows", result.RowsAffected),
+	)
+
+	return nil
+}
+
+// MergeMovieReferences moves the ratings of merged movies to the surviving
+// movie. A user who rated several of them keeps the latest rating, as
+// idx_movie_ratings_user_movie allows only one.
+func (r *Repository) MergeMovieReferences(ctx context.Context, survivorID string, duplicateIDs []string) error {
+	db := r.db.WithContext(ctx)
+
+	ranked := db.Model(&MovieRating{}).
+		Select("id, row_number() OVER (PARTITION BY user_id ORDER BY updated_at DESC NULLS LAST) AS position").
+		Where("movie_id IN ?", append([]string{survivorID}, duplicateIDs...))
+	superseded := db.Table("(?) AS ranked", ranked).Select("id").Where("position > 1")
+	if err := db.Where("id IN (?)", superseded).Delete(&MovieRating{}).Error; err != nil {
+		return fmt.Errorf("failed to remove superseded ratings: %w", err)
+	}
+
+	result := db.Unscoped().Model(&MovieRating{}).
+		Where("movie_id IN ?", duplicateIDs).
+		Update("movie_id", survivorID)
+	if result.Error != nil {
+		return fmt.Errorf("failed to move ratings: %w", result.Error)
+	}
+	r.logger.DebugContext(ctx, "ratings moved to merged movie",
+		slog.String("movie_id", survivorID),
+		slog.Int64("rows", result.RowsAffected),
+	)
+
+	return nil
 }
@@ -2,66 +2,206 @@ package rating
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"log/slog"
 
+	"event-driven-go/internal/domains/movies"
+	"event-driven-go/internal/domains/user"
 	"event-driven-go/internal/shared"
+	schema "github.com/nameteos/my-movies-db-schema/mongodb"
 )
 
+// UserLookup and MovieLookup give the rating domain read access to the users
+// and movies that ratings reference.
+type UserLookup interface {
+	GetUserByID(ctx context.Context, id string) (*user.User, error)
+}
+
+type MovieLookup interface {
+	GetMovieByID(ctx context.Context, id string) (*schema.Movie, error)
+}
+
 type Service struct {
-	eventBus *shared.EventBus
+	repository *Repository
+	users      UserLookup
+	movies     MovieLookup
+	eventBus   *shared.EventBus
+	logger     *slog.Logger
 }
 
-func NewService(eventBus *shared.EventBus) *Service {
+func NewService(repository *Repository, users UserLookup, movies MovieLookup, eventBus *shared.EventBus, logger *slog.Logger) *Service {
 	return &Service{
-		eventBus: eventBus,
+		repository: repository,
+		users:      users,
+		movies:     movies,
+		eventBus:   eventBus,
+		logger:     logger.With(slog.String("domain", "rating")),
 	}
 }
 
-func (s *Service) RateMovie(ctx context.Context, userID, movieID, title string, rating float64, review string) error {
+// RateMovie stores the user's rating of a movie, replacing an earlier one.
+func (s *Service) RateMovie(ctx context.Context, userID, movieID string, rating float64, review string) (*MovieRating, error) {
 	// todo use validator for all service methods?
 	if userID == "" {
-		return fmt.Errorf("user ID cannot be empty")
+		return nil, shared.NewFieldError("user_id", "must not be empty")
 	}
 	if movieID == "" {
-		return fmt.Errorf("movie ID cannot be empty")
-	}
-	if title == "" {
-		return fmt.Errorf("movie title cannot be empty")
+		return nil, shared.NewFieldError("movie_id", "must not be empty")
 	}
 	if rating < 0 || rating > 5 {
-		return fmt.Errorf("rating must be between 0 and 5, got %.1f", rating)
+		return nil, shared.NewFieldError("rating", "must be between 0 and 5")
+	}
+	if err := shared.Authorize(ctx, shared.ActionModifyUserData, userID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.users.GetUserByID(ctx, userID); err != nil {
+		return nil, err
+	}
+	movie, err := s.movies.GetMovieByID(ctx, movieID)
+	if err != nil {
+		return nil, err
+	}
+
+	movieRating, err := s.repository.UpsertRating(ctx, userID, movieID, rating, review)
+	if err != nil {
+		return nil, err
 	}
 
 	// Create and publish the event
-	event := NewMovieRatedEvent(userID, movieID, title, rating, review)
+	event := NewMovieRatedEvent(userID, movieID, movie.Title, rating, review)
 
 	if err := s.eventBus.Publish(ctx, event); err != nil {
-		return fmt.Errorf("failed to publish movie rating event: %w", err)
+		// Log error but don't fail the operation since the rating was stored
+		s.logger.WarnContext(ctx, "failed to publish movie rating event", slog.Any("error", err))
 	}
 
-	return nil
+	return movieRating, nil
 }
 
-func (s *Service) RemoveRating(ctx context.Context, userID, movieID, title string) error {
+func (s *Service) RemoveRating(ctx context.Context, userID, movieID string) error {
 	if userID == "" {
-		return fmt.Errorf("user ID cannot be empty")
+		return shared.NewFieldError("user_id", "must not be empty")
 	}
 	if movieID == "" {
-		return fmt.Errorf("movie ID cannot be empty")
+		return shared.NewFieldError("movie_id", "must not be empty")
+	}
+	if err := shared.Authorize(ctx, shared.ActionRemoveRating, userID); err != nil {
+		return err
+	}
+
+	if _, err := s.users.GetUserByID(ctx, userID); err != nil {
+		return err
+	}
+
+	if err := s.repository.RemoveRating(ctx, userID, movieID); err != nil {
+		return err
 	}
-	if title == "" {
-		return fmt.Errorf("movie title cannot be empty")
+
+	// Ratings of movies that have since left the catalog can still be removed,
+	// the event then goes out without a title
+	var title string
+	movie, err := s.movies.GetMovieByID(ctx, movieID)
+	switch {
+	case err == nil:
+		title = movie.Title
+	case !errors.Is(err, movies.ErrMovieNotFound):
+		s.logger.WarnContext(ctx, "failed to look up unrated movie", slog.Any("error", err))
 	}
 
 	event := NewMovieUnratedEvent(userID, movieID, title)
 
 	if err := s.eventBus.Publish(ctx, event); err != nil {
-		return fmt.Errorf("failed to publish movie unrated event: %w", err)
+		s.logger.WarnContext(ctx, "failed to publish movie unrated event", slog.Any("error", err))
 	}
 
 	return nil
 }
 
-func (s *Service) GetMovieRatings(ctx context.Context, movieID string) error {
-	return nil
+// GetUserRatings returns a page of the ratings a user gave, newest first.
+func (s *Service) GetUserRatings(ctx context.Context, userID string, page shared.PageRequest) (*shared.Page[*MovieRating], error) {
+	if userID == "" {
+		return nil, shared.NewFieldError("user_id", "must not be empty")
+	}
+	if err := shared.Authorize(ctx, shared.ActionReadUserData, userID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.users.GetUserByID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return s.repository.GetUserRatings(ctx, userID, page.WithDefaults())
+}
+
+// GetMovieRatings returns a page of a movie's ratings, newest first.
+func (s *Service) GetMovieRatings(ctx context.Context, movieID string, page shared.PageRequest) (*shared.Page[*MovieRating], error) {
+	if _, err := s.movies.GetMovieByID(ctx, movieID); err != nil {
+		return nil, err
+	}
+
+	return s.repository.GetMovieRatings(ctx, movieID, page.WithDefaults())
+}
+
+func (s *Service) GetMovieRatingSummary(ctx context.Context, movieID string) (*RatingSummary, error) {
+	if _, err := s.movies.GetMovieByID(ctx, movieID); err != nil {
+		return nil, err
+	}
+
+	average, count, err := s.repository.GetMovieAverageRating(ctx, movieID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RatingSummary{MovieID: movieID, AvgRating: average, Count: int64(count)}, nil
+}
+
+// GetUserRatingsForMovies returns the user's ratings of those movies they rated.
+func (s *Service) GetUserRatingsForMovies(ctx context.Context, userID string, movieIDs []string) ([]*MovieRating, error) {
+	if len(movieIDs) == 0 {
+		return nil, nil
+	}
+
+	return s.repository.GetUserRatingsForMovies(ctx, userID, movieIDs)
+}
+
+// GetRatingSummaries summarizes the ratings of several movies at once. Movies
+// without ratings get a summary with a zero count.
+func (s *Service) GetRatingSummaries(ctx context.Context, movieIDs []string) ([]*RatingSummary, error) {
+	if len(movieIDs) == 0 {
+		return nil, nil
+	}
+
+	found, err := s.repository.GetRatingSummaries(ctx, movieIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byMovie := make(map[string]*RatingSummary, len(found))
+	for _, summary := range found {
+		byMovie[summary.MovieID] = summary
+	}
+
+	summaries := make([]*RatingSummary, 0, len(movieIDs))
+	for _, movieID := range movieIDs {
+		summary, ok := byMovie[movieID]
+		if !ok {
+			summary = &RatingSummary{MovieID: movieID}
+		}
+		summaries = append(summaries, summary)
+	}
+
+	return summaries, nil
+}
+
+func (s *Service) GetRatingDistribution(ctx context.Context, movieID string) (map[string]int64, error) {
+	if _, err := s.movies.GetMovieByID(ctx, movieID); err != nil {
+		return nil, err
+	}
+
+	return s.repository.GetRatingDistribution(ctx, movieID)
+}
+
+func (s *Service) GetTopRatedMovies(ctx context.Context, limit int) ([]*RatingSummary, error) {
+	return s.repository.GetTopRatedMovies(ctx, limit)
 }
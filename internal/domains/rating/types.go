@@ -1,12 +1,14 @@
 package rating
 
 import (
-	"fmt"
 	"time"
 
+	"event-driven-go/internal/shared"
 	"gorm.io/gorm"
 )
 
+var ErrRatingNotFound = shared.NewNotFoundError("rating")
+
 type MovieRating struct {
 	ID        string         `json:"id" db:"id" gorm:"primaryKey;type:varchar(36)"`
 	UserID    string         `json:"user_id" db:"user_id" gorm:"type:varchar(36);not null;index"`
@@ -15,17 +17,24 @@ type MovieRating struct {
 	Review    string         `json:"review" db:"review" gorm:"type:text"`
 	CreatedAt time.Time      `json:"created_at" db:"created_at" gorm:"autoCreateTime"`
 	UpdatedAt time.Time      `json:"updated_at" db:"updated_at" gorm:"autoUpdateTime"`
-	DeletedAt gorm.DeletedAt `gorm:"index"` // Soft delete support
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"` // Soft delete support
 }
 
 func (MovieRating) TableName() string {
 	return "movie_ratings"
 }
 
+// RatingSummary is the average of all ratings a movie received.
+type RatingSummary struct {
+	MovieID   string  `json:"movie_id"`
+	AvgRating float64 `json:"avg_rating"`
+	Count     int64   `json:"count"`
+}
+
 func (mr *MovieRating) BeforeCreate(tx *gorm.DB) error {
 	// Validate rating is within range
 	if mr.Rating < 0 || mr.Rating > 5 {
-		return fmt.Errorf("rating must be between 0 and 5")
+		return shared.NewFieldError("rating", "must be between 0 and 5")
 	}
 	return nil
 }
@@ -18,9 +18,10 @@ type UserRegisteredEvent struct {
 }
 
 func init() {
-	shared.GlobalEventBus.RegisterEventType(UserRegisteredEventType, &UserRegisteredEvent{}, &Handler{})
-	shared.GlobalEventBus.RegisterEventType(UserUpdatedEventType, &UserUpdatedEvent{}, &Handler{})
-	shared.GlobalEventBus.RegisterEventType(UserDeletedEventType, &UserDeletedEvent{}, &Handler{})
+	handler := NewHandler(shared.Logger)
+	shared.GlobalEventBus.RegisterEventType(UserRegisteredEventType, &UserRegisteredEvent{}, handler)
+	shared.GlobalEventBus.RegisterEventType(UserUpdatedEventType, &UserUpdatedEvent{}, handler)
+	shared.GlobalEventBus.RegisterEventType(UserDeletedEventType, &UserDeletedEvent{}, handler)
 }
 
 func (e UserRegisteredEvent) GetPayload() interface{} {
@@ -0,0 +1,153 @@
+package user
+
+import (
+	"context"
+	"log/slog"
+	"strings"
+
+	moviesdbv1 "event-driven-go/internal/gen/moviesdb/v1"
+	"event-driven-go/internal/shared"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GRPCServer implements moviesdb.v1.UserService on top of the service.
+type GRPCServer struct {
+	moviesdbv1.UnimplementedUserServiceServer
+	service *Service
+	logger  *slog.Logger
+}
+
+func NewGRPCServer(service *Service, logger *slog.Logger) *GRPCServer {
+	return &GRPCServer{
+		service: service,
+		logger:  logger.With(slog.String("domain", "user")),
+	}
+}
+
+func (s *GRPCServer) Register(server *grpc.Server) {
+	moviesdbv1.RegisterUserServiceServer(server, s)
+}
+
+func (s *GRPCServer) RegisterUser(ctx context.Context, req *moviesdbv1.RegisterUserRequest) (*moviesdbv1.User, error) {
+	username := strings.TrimSpace(req.GetUsername())
+	email := strings.TrimSpace(req.GetEmail())
+	fields := validateUserFields(&username, &email)
+	if problem := validatePassword(req.GetPassword()); problem != "" {
+		fields["password"] = problem
+	}
+	if len(fields) > 0 {
+		return nil, shared.NewValidationError("invalid user", fields)
+	}
+
+	user, err := s.service.RegisterUser(ctx, username, email, req.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoUser(user), nil
+}
+
+func (s *GRPCServer) GetUser(ctx context.Context, req *moviesdbv1.GetUserRequest) (*moviesdbv1.User, error) {
+	user, err := s.service.GetUser(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoUser(user), nil
+}
+
+func (s *GRPCServer) GetUserByUsername(ctx context.Context, req *moviesdbv1.GetUserByUsernameRequest) (*moviesdbv1.User, error) {
+	user, err := s.service.GetUserByUsername(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoUser(user), nil
+}
+
+func (s *GRPCServer) ListUsers(ctx context.Context, req *moviesdbv1.ListUsersRequest) (*moviesdbv1.ListUsersResponse, error) {
+	page, err := shared.ValidatePageRequest(req.GetLimit(), req.GetOffset(), req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := s.service.ListUsers(ctx, page)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &moviesdbv1.ListUsersResponse{
+		Users:      make([]*moviesdbv1.User, 0, len(users.Items)),
+		NextCursor: users.NextCursor,
+		PrevCursor: users.PrevCursor,
+	}
+	for _, user := range users.Items {
+		response.Users = append(response.Users, toProtoUser(user))
+	}
+
+	return response, nil
+}
+
+func (s *GRPCServer) UpdateUser(ctx context.Context, req *moviesdbv1.UpdateUserRequest) (*moviesdbv1.User, error) {
+	var username, email *string
+	if req.Username != nil {
+		trimmed := strings.TrimSpace(req.GetUsername())
+		username = &trimmed
+	}
+	if req.Email != nil {
+		trimmed := strings.TrimSpace(req.GetEmail())
+		email = &trimmed
+	}
+	if fields := validateUserFields(username, email); len(fields) > 0 {
+		return nil, shared.NewValidationError("invalid user", fields)
+	}
+
+	user, err := s.service.GetUserByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	if username != nil {
+		user.Username = *username
+	}
+	if email != nil {
+		user.Email = *email
+	}
+
+	updatedUser, err := s.service.UpdateUser(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoUser(updatedUser), nil
+}
+
+func (s *GRPCServer) DeleteUser(ctx context.Context, req *moviesdbv1.DeleteUserRequest) (*emptypb.Empty, error) {
+	if err := s.service.DeleteUser(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *GRPCServer) ChangeUserRole(ctx context.Context, req *moviesdbv1.ChangeUserRoleRequest) (*moviesdbv1.User, error) {
+	user, err := s.service.ChangeUserRole(ctx, req.GetId(), shared.Role(req.GetRole()))
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoUser(user), nil
+}
+
+func toProtoUser(user *User) *moviesdbv1.User {
+	return &moviesdbv1.User{
+		Id:        user.ID,
+		Username:  user.Username,
+		Email:     user.Email,
+		CreatedAt: timestamppb.New(user.CreatedAt),
+		UpdatedAt: timestamppb.New(user.UpdatedAt),
+		Role:      string(user.Role),
+	}
+}
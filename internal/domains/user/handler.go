@@ -3,12 +3,20 @@ package user
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
 
 	"event-driven-go/internal/shared"
 )
 
-type Handler struct{}
+type Handler struct {
+	logger *slog.Logger
+}
+
+func NewHandler(logger *slog.Logger) *Handler {
+	return &Handler{
+		logger: logger.With(slog.String("domain", "user")),
+	}
+}
 
 func (h *Handler) Handle(ctx context.Context, event shared.Event) error {
 	switch e := event.(type) {
@@ -30,8 +38,10 @@ func (h *Handler) CanHandle(eventType string) bool {
 }
 
 func (h *Handler) handleUserRegistered(ctx context.Context, event *UserRegisteredEvent) error {
-	log.Printf("👤 USER: New user registered - '%s' (%s)",
-		event.Username, event.Email)
+	h.logger.InfoContext(ctx, "new user registered",
+		slog.String("user_id", event.UserID),
+		slog.String("username", event.Username),
+	)
 
 	// todo service inject
 
@@ -40,14 +50,20 @@ func (h *Handler) handleUserRegistered(ctx context.Context, event *UserRegistere
 
 // handleUserUpdated processes UserUpdatedEvent
 func (h *Handler) handleUserUpdated(ctx context.Context, event *UserUpdatedEvent) error {
-	log.Printf("👤 USER: User '%s' updated their profile", event.Username)
+	h.logger.InfoContext(ctx, "user updated their profile",
+		slog.String("user_id", event.UserID),
+		slog.String("username", event.Username),
+	)
 
 	return nil
 }
 
 // handleUserDeleted processes UserDeletedEvent
 func (h *Handler) handleUserDeleted(ctx context.Context, event *UserDeletedEvent) error {
-	log.Printf("👤 USER: User '%s' has been deleted", event.Username)
+	h.logger.InfoContext(ctx, "user has been deleted",
+		slog.String("user_id", event.UserID),
+		slog.String("username", event.Username),
+	)
 
 	return nil
 }
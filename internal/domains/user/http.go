@@ -0,0 +1,299 @@
+package user
+
+import (
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"event-driven-go/internal/shared"
+)
+
+var usernamePattern = regexp.MustCompile(`^[a-zA-Z0-9_.-]{3,100}$`)
+
+type HTTPHandler struct {
+	service *Service
+	logger  *slog.Logger
+}
+
+func NewHTTPHandler(service *Service, logger *slog.Logger) *HTTPHandler {
+	return &HTTPHandler{
+		service: service,
+		logger:  logger.With(slog.String("domain", "user")),
+	}
+}
+
+func (h *HTTPHandler) RegisterRoutes(router *shared.Router) {
+	router.Handle(shared.Route{
+		Method:   http.MethodPost,
+		Path:     "/users",
+		Tag:      "users",
+		Summary:  "Register a user",
+		Request:  RegisterUserRequest{},
+		Response: User{},
+		Status:   http.StatusCreated,
+		Errors:   []int{http.StatusBadRequest, http.StatusConflict, http.StatusUnprocessableEntity},
+	}, h.registerUser)
+	router.Handle(shared.Route{
+		Method:  http.MethodGet,
+		Path:    "/users",
+		Tag:     "users",
+		Summary: "List users, newest first, or find one by username (admins only)",
+		Query: append([]shared.QueryParam{
+			{Name: "username", Description: "Return only the user with this username"},
+		}, shared.PaginationParams...),
+		Response: UserListResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusForbidden},
+		Auth:     true,
+	}, h.listUsers)
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/users/recent",
+		Tag:      "users",
+		Summary:  "Most recently registered users (admins only)",
+		Query:    []shared.QueryParam{shared.LimitParam},
+		Response: UserListResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusForbidden},
+		Auth:     true,
+	}, h.recentUsers)
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/users/{id}",
+		Tag:      "users",
+		Summary:  "Get a user",
+		Response: User{},
+		Errors:   []int{http.StatusForbidden, http.StatusNotFound},
+		Auth:     true,
+	}, h.getUser)
+	router.Handle(shared.Route{
+		Method:   http.MethodPatch,
+		Path:     "/users/{id}",
+		Tag:      "users",
+		Summary:  "Update username and/or email",
+		Request:  UpdateUserRequest{},
+		Response: User{},
+		Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity},
+		Auth:     true,
+	}, h.updateUser)
+	router.Handle(shared.Route{
+		Method:  http.MethodDelete,
+		Path:    "/users/{id}",
+		Tag:     "users",
+		Summary: "Delete a user and their data",
+		Status:  http.StatusNoContent,
+		Errors:  []int{http.StatusForbidden, http.StatusNotFound},
+		Auth:    true,
+	}, h.deleteUser)
+	router.Handle(shared.Route{
+		Method:   http.MethodPut,
+		Path:     "/users/{id}/role",
+		Tag:      "users",
+		Summary:  "Change a user's role (admins only)",
+		Request:  ChangeRoleRequest{},
+		Response: User{},
+		Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusConflict, http.StatusUnprocessableEntity},
+		Auth:     true,
+	}, h.changeRole)
+}
+
+type RegisterUserRequest struct {
+	Username string `json:"username"`
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+type UpdateUserRequest struct {
+	Username *string `json:"username,omitempty"`
+	Email    *string `json:"email,omitempty"`
+}
+
+type ChangeRoleRequest struct {
+	Role shared.Role `json:"role"`
+}
+
+type UserListResponse struct {
+	Data       []*User           `json:"data"`
+	Pagination shared.Pagination `json:"pagination"`
+}
+
+func (h *HTTPHandler) registerUser(w http.ResponseWriter, r *http.Request) {
+	var request RegisterUserRequest
+	if err := shared.DecodeJSON(w, r, &request); err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	request.Username = strings.TrimSpace(request.Username)
+	request.Email = strings.TrimSpace(request.Email)
+	fields := validateUserFields(&request.Username, &request.Email)
+	if problem := validatePassword(request.Password); problem != "" {
+		fields["password"] = problem
+	}
+	if len(fields) > 0 {
+		shared.WriteError(w, r, http.StatusUnprocessableEntity, "invalid user", fields)
+		return
+	}
+
+	user, err := h.service.RegisterUser(r.Context(), request.Username, request.Email, request.Password)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusCreated, user)
+}
+
+// listUsers pages through all users, or looks up a single one when the
+// username query parameter is set.
+func (h *HTTPHandler) listUsers(w http.ResponseWriter, r *http.Request) {
+	if username := r.URL.Query().Get("username"); username != "" {
+		h.findByUsername(w, r, username)
+		return
+	}
+
+	page, ok := shared.ParsePageRequest(w, r)
+	if !ok {
+		return
+	}
+
+	users, err := h.service.ListUsers(r.Context(), page)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, UserListResponse{
+		Data:       users.Items,
+		Pagination: shared.PaginationOf(users, page),
+	})
+}
+
+func (h *HTTPHandler) findByUsername(w http.ResponseWriter, r *http.Request, username string) {
+	users := []*User{}
+
+	user, err := h.service.GetUserByUsername(r.Context(), username)
+	switch {
+	case err == nil:
+		users = append(users, user)
+	case !errors.Is(err, ErrUserNotFound):
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, UserListResponse{
+		Data:       users,
+		Pagination: shared.Pagination{Limit: 1, Count: len(users)},
+	})
+}
+
+func (h *HTTPHandler) recentUsers(w http.ResponseWriter, r *http.Request) {
+	limit, _, ok := shared.ParsePagination(w, r)
+	if !ok {
+		return
+	}
+
+	users, err := h.service.GetRecentUsers(r.Context(), limit)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, UserListResponse{
+		Data:       users,
+		Pagination: shared.Pagination{Limit: limit, Count: len(users)},
+	})
+}
+
+func (h *HTTPHandler) getUser(w http.ResponseWriter, r *http.Request) {
+	user, err := h.service.GetUser(r.Context(), r.PathValue("id"))
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, user)
+}
+
+func (h *HTTPHandler) updateUser(w http.ResponseWriter, r *http.Request) {
+	var request UpdateUserRequest
+	if err := shared.DecodeJSON(w, r, &request); err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	if request.Username != nil {
+		*request.Username = strings.TrimSpace(*request.Username)
+	}
+	if request.Email != nil {
+		*request.Email = strings.TrimSpace(*request.Email)
+	}
+	if fields := validateUserFields(request.Username, request.Email); len(fields) > 0 {
+		shared.WriteError(w, r, http.StatusUnprocessableEntity, "invalid user", fields)
+		return
+	}
+
+	user, err := h.service.GetUserByID(r.Context(), r.PathValue("id"))
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	if request.Username != nil {
+		user.Username = *request.Username
+	}
+	if request.Email != nil {
+		user.Email = *request.Email
+	}
+
+	updatedUser, err := h.service.UpdateUser(r.Context(), user)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, updatedUser)
+}
+
+func (h *HTTPHandler) deleteUser(w http.ResponseWriter, r *http.Request) {
+	if err := h.service.DeleteUser(r.Context(), r.PathValue("id")); err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *HTTPHandler) changeRole(w http.ResponseWriter, r *http.Request) {
+	var request ChangeRoleRequest
+	if err := shared.DecodeJSON(w, r, &request); err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	user, err := h.service.ChangeUserRole(r.Context(), r.PathValue("id"), request.Role)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, user)
+}
+
+// validateUserFields checks the fields that are present; nil fields are skipped
+// so the same rules serve registration and partial updates.
+func validateUserFields(username, email *string) map[string]string {
+	fields := make(map[string]string)
+
+	if username != nil && !usernamePattern.MatchString(*username) {
+		fields["username"] = "must be 3-100 characters of letters, digits, '.', '_' or '-'"
+	}
+	if email != nil {
+		if address, err := mail.ParseAddress(*email); err != nil || address.Address != *email || len(*email) > 255 {
+			fields["email"] = "must be a valid email address"
+		}
+	}
+
+	return fields
+}
@@ -0,0 +1,52 @@
+package user
+
+import (
+	"fmt"
+	"sync"
+
+	"event-driven-go/internal/shared"
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	minPasswordLength = 8
+	// bcrypt only looks at the first 72 bytes of a password
+	maxPasswordLength = 72
+)
+
+// dummyPasswordHash is compared against when a user does not exist.
+var dummyPasswordHash = sync.OnceValue(func() string {
+	return mustHashPassword("not a real password")
+})
+
+func validatePassword(password string) string {
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		return fmt.Sprintf("must be %d to %d bytes long", minPasswordLength, maxPasswordLength)
+	}
+	return ""
+}
+
+func hashPassword(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), shared.Config.Auth.BcryptCost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password: %w", err)
+	}
+	return string(hash), nil
+}
+
+func mustHashPassword(password string) string {
+	hash, err := hashPassword(password)
+	if err != nil {
+		panic(err)
+	}
+	return hash
+}
+
+// comparePassword reports whether password matches hash. An empty hash, as
+// stored for users without a password, matches nothing.
+func comparePassword(hash, password string) bool {
+	if hash == "" {
+		return false
+	}
+	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+}
@@ -2,44 +2,60 @@ package user
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"time"
 
+	"event-driven-go/internal/shared"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
 )
 
 type RepositoryInterface interface {
-	CreateUser(ctx context.Context, username, email string) (*User, error)
+	CreateUser(ctx context.Context, username, email, passwordHash string) (*User, error)
 	GetUserByID(ctx context.Context, id string) (*User, error)
+	GetUsersByIDs(ctx context.Context, ids []string) ([]*User, error)
 	GetUserByUsername(ctx context.Context, username string) (*User, error)
 	GetUserByEmail(ctx context.Context, email string) (*User, error)
 	UpdateUser(ctx context.Context, user *User) (*User, error)
+	UpdateUserRole(ctx context.Context, id string, role shared.Role) error
 	DeleteUser(ctx context.Context, id string) error
-	ListUsers(ctx context.Context, limit, offset int) ([]*User, error)
+	ListUsers(ctx context.Context, page shared.PageRequest) (*shared.Page[*User], error)
 	UserExists(ctx context.Context, username, email string) (bool, error)
 	GetActiveUserCount(ctx context.Context) (int64, error)
 	GetRecentUsers(ctx context.Context, limit int) ([]*User, error)
 }
 
 type Repository struct {
-	db *gorm.DB
+	db     *gorm.DB
+	logger *slog.Logger
 }
 
-func NewRepository(db *gorm.DB) *Repository {
-	return &Repository{db: db}
+func NewRepository(db *gorm.DB, logger *slog.Logger) *Repository {
+	return &Repository{
+		db:     db,
+		logger: logger.With(slog.String("repository", "user")),
+	}
 }
 
-func (r *Repository) CreateUser(ctx context.Context, username, email string) (*User, error) {
+func (r *Repository) CreateUser(ctx context.Context, username, email, passwordHash string) (*User, error) {
 	user := &User{
-		ID:       uuid.New().String(),
-		Username: username,
-		Email:    email,
+		ID:           uuid.New().String(),
+		Username:     username,
+		Email:        email,
+		PasswordHash: passwordHash,
+		Role:         shared.RoleUser,
 	}
 
 	result := r.db.WithContext(ctx).Create(user)
 	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return nil, ErrUserAlreadyExists
+		}
 		return nil, fmt.Errorf("failed to create user: %w", result.Error)
 	}
+	r.logger.DebugContext(ctx, "user created", slog.String("user_id", user.ID))
 
 	return user, nil
 }
@@ -49,8 +65,8 @@ func (r *Repository) GetUserByID(ctx context.Context, id string) (*User, error)
 
 	result := r.db.WithContext(ctx).Where("id = ?", id).First(&user)
 	if result.Error != nil {
-		if result.Error == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("user not found")
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrUserNotFound
 		}
 		return nil, fmt.Errorf("failed to get user: %w", result.Error)
 	}
@@ -58,13 +74,26 @@ func (r *Repository) GetUserByID(ctx context.Context, id string) (*User, error)
 	return &user, nil
 }
 
+// GetUsersByIDs returns the users with the given IDs that exist, in no
+// particular order.
+func (r *Repository) GetUsersByIDs(ctx context.Context, ids []string) ([]*User, error) {
+	var users []*User
+
+	result := r.db.WithContext(ctx).Where("id IN ?", ids).Find(&users)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to get users: %w", result.Error)
+	}
+
+	return users, nil
+}
+
 func (r *Repository) GetUserByUsername(ctx context.Context, username string) (*User, error) {
 	var user User
 
 	result := r.db.WithContext(ctx).Where("username = ?", username).First(&user)
 	if result.Error != nil {
-		if result.Error == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("user not found")
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrUserNotFound
 		}
 		return nil, fmt.Errorf("failed to get user: %w", result.Error)
 	}
@@ -77,8 +106,8 @@ func (r *Repository) GetUserByEmail(ctx context.Context, email string) (*User, e
 
 	result := r.db.WithContext(ctx).Where("email = ?", email).First(&user)
 	if result.Error != nil {
-		if result.Error == gorm.ErrRecordNotFound {
-			return nil, fmt.Errorf("user not found")
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, ErrUserNotFound
 		}
 		return nil, fmt.Errorf("failed to get user: %w", result.Error)
 	}
@@ -89,12 +118,28 @@ func (r *Repository) GetUserByEmail(ctx context.Context, email string) (*User, e
 func (r *Repository) UpdateUser(ctx context.Context, user *User) (*User, error) {
 	result := r.db.WithContext(ctx).Save(user)
 	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrDuplicatedKey) {
+			return nil, ErrUserAlreadyExists
+		}
 		return nil, fmt.Errorf("failed to update user: %w", result.Error)
 	}
 
 	return user, nil
 }
 
+func (r *Repository) UpdateUserRole(ctx context.Context, id string, role shared.Role) error {
+	result := r.db.WithContext(ctx).Model(&User{}).Where("id = ?", id).Update("role", role)
+	if result.Error != nil {
+		return fmt.Errorf("failed to update user role: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrUserNotFound
+	}
+
+	return nil
+}
+
 func (r *Repository) DeleteUser(ctx context.Context, id string) error {
 	result := r.db.WithContext(ctx).Where("id = ?", id).Delete(&User{})
 	if result.Error != nil {
@@ -102,26 +147,38 @@ func (r *Repository) DeleteUser(ctx context.Context, id string) error {
 	}
 
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("user not found")
+		return ErrUserNotFound
 	}
+	r.logger.DebugContext(ctx, "user deleted", slog.String("user_id", id))
 
 	return nil
 }
 
-func (r *Repository) ListUsers(ctx context.Context, limit, offset int) ([]*User, error) {
-	var users []*User
+// userCursor is the sort key of ListUsers, newest first.
+type userCursor struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        string    `json:"id"`
+}
 
-	result := r.db.WithContext(ctx).
-		Order("created_at DESC").
-		Limit(limit).
-		Offset(offset).
+func (r *Repository) ListUsers(ctx context.Context, page shared.PageRequest) (*shared.Page[*User], error) {
+	var key userCursor
+	direction, err := page.Seek(&key)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []*User
+	result := shared.KeysetPage(r.db.WithContext(ctx), page, direction,
+		[]string{"created_at", "id"}, key.CreatedAt, key.ID).
 		Find(&users)
 
 	if result.Error != nil {
 		return nil, fmt.Errorf("failed to list users: %w", result.Error)
 	}
 
-	return users, nil
+	return shared.NewPage(users, page, direction, func(user *User) interface{} {
+		return userCursor{CreatedAt: user.CreatedAt, ID: user.ID}
+	}), nil
 }
 
 func (r *Repository) UserExists(ctx context.Context, username, email string) (bool, error) {
@@ -164,7 +221,3 @@ func (r *Repository) GetRecentUsers(ctx context.Context, limit int) ([]*User, er
 
 	return users, nil
 }
-
-func (r *Repository) AutoMigrate() error {
-	return r.db.AutoMigrate(&User{})
-}
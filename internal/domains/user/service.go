@@ -2,30 +2,60 @@ package user
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 
 	"event-driven-go/internal/shared"
 )
 
+// UserDataRepository is implemented by repositories of other domains that hold
+// per-user data, which has to be removed together with the user.
+type UserDataRepository interface {
+	DeleteUserData(ctx context.Context, userID string) error
+}
+
+// TxRepositories are the transaction-bound repositories handed out by the
+// service's unit of work.
+type TxRepositories struct {
+	Users    RepositoryInterface
+	UserData []UserDataRepository
+	Outbox   *shared.Outbox
+}
+
 type Service struct {
 	repository RepositoryInterface
+	unitOfWork *shared.UnitOfWork[TxRepositories]
 	eventBus   *shared.EventBus
+	logger     *slog.Logger
 }
 
-func NewService(repository RepositoryInterface, eventBus *shared.EventBus) *Service {
+func NewService(
+	repository RepositoryInterface,
+	unitOfWork *shared.UnitOfWork[TxRepositories],
+	eventBus *shared.EventBus,
+	logger *slog.Logger,
+) *Service {
 	return &Service{
 		repository: repository,
+		unitOfWork: unitOfWork,
 		eventBus:   eventBus,
+		logger:     logger.With(slog.String("domain", "user")),
 	}
 }
 
-func (s *Service) RegisterUser(ctx context.Context, username, email string) (*User, error) {
+// RegisterUser creates a user who logs in with the given password. Only a
+// bcrypt hash of the password is stored.
+func (s *Service) RegisterUser(ctx context.Context, username, email, password string) (*User, error) {
 	// Validate input
 	if username == "" {
-		return nil, fmt.Errorf("username cannot be empty")
+		return nil, shared.NewFieldError("username", "must not be empty")
 	}
 	if email == "" {
-		return nil, fmt.Errorf("email cannot be empty")
+		return nil, shared.NewFieldError("email", "must not be empty")
+	}
+	if problem := validatePassword(password); problem != "" {
+		return nil, shared.NewFieldError("password", problem)
 	}
 
 	// Check if user already exists
@@ -34,11 +64,16 @@ func (s *Service) RegisterUser(ctx context.Context, username, email string) (*Us
 		return nil, fmt.Errorf("failed to check user existence: %w", err)
 	}
 	if exists {
-		return nil, fmt.Errorf("user with username '%s' or email '%s' already exists", username, email)
+		return nil, fmt.Errorf("%w: username '%s' or email '%s' is taken", ErrUserAlreadyExists, username, email)
+	}
+
+	passwordHash, err := hashPassword(password)
+	if err != nil {
+		return nil, err
 	}
 
 	// Create user in repository
-	user, err := s.repository.CreateUser(ctx, username, email)
+	user, err := s.repository.CreateUser(ctx, username, email, passwordHash)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create user: %w", err)
 	}
@@ -47,7 +82,28 @@ func (s *Service) RegisterUser(ctx context.Context, username, email string) (*Us
 	event := NewUserRegisteredEvent(user.ID, user.Username, user.Email)
 	if err := s.eventBus.Publish(ctx, event); err != nil {
 		// Log error but don't fail the operation since user was created
-		fmt.Printf("Warning: failed to publish user registered event: %v\n", err)
+		s.logger.WarnContext(ctx, "failed to publish user registered event", slog.Any("error", err))
+	}
+
+	return user, nil
+}
+
+// VerifyPassword returns the user with the given username when the password
+// matches. Unknown users and wrong passwords fail alike with ErrInvalidCredentials.
+func (s *Service) VerifyPassword(ctx context.Context, username, password string) (*User, error) {
+	user, err := s.repository.GetUserByUsername(ctx, username)
+	if errors.Is(err, ErrUserNotFound) {
+		// Spend the time of a comparison anyway, so response times do not
+		// reveal which usernames exist
+		comparePassword(dummyPasswordHash(), password)
+		return nil, ErrInvalidCredentials
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !comparePassword(user.PasswordHash, password) {
+		return nil, ErrInvalidCredentials
 	}
 
 	return user, nil
@@ -55,15 +111,43 @@ func (s *Service) RegisterUser(ctx context.Context, username, email string) (*Us
 
 func (s *Service) GetUserByID(ctx context.Context, id string) (*User, error) {
 	if id == "" {
-		return nil, fmt.Errorf("user ID cannot be empty")
+		return nil, shared.NewFieldError("user_id", "must not be empty")
+	}
+
+	return s.repository.GetUserByID(ctx, id)
+}
+
+// GetUser returns the account of a user to the user themselves or an admin.
+// GetUserByID is the unguarded lookup the other domains use.
+func (s *Service) GetUser(ctx context.Context, id string) (*User, error) {
+	if id == "" {
+		return nil, shared.NewFieldError("user_id", "must not be empty")
+	}
+	if err := shared.Authorize(ctx, shared.ActionReadUser, id); err != nil {
+		return nil, err
 	}
 
 	return s.repository.GetUserByID(ctx, id)
 }
 
+// GetUsersByIDs retrieves several users at once, leaving out the IDs that do
+// not exist.
+func (s *Service) GetUsersByIDs(ctx context.Context, ids []string) ([]*User, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	return s.repository.GetUsersByIDs(ctx, ids)
+}
+
+// GetUserByUsername looks a user up by username, which only admins may, as
+// it tells whether an account exists.
 func (s *Service) GetUserByUsername(ctx context.Context, username string) (*User, error) {
 	if username == "" {
-		return nil, fmt.Errorf("username cannot be empty")
+		return nil, shared.NewFieldError("username", "must not be empty")
+	}
+	if err := shared.Authorize(ctx, shared.ActionListUsers, ""); err != nil {
+		return nil, err
 	}
 
 	return s.repository.GetUserByUsername(ctx, username)
@@ -71,7 +155,7 @@ func (s *Service) GetUserByUsername(ctx context.Context, username string) (*User
 
 func (s *Service) GetUserByEmail(ctx context.Context, email string) (*User, error) {
 	if email == "" {
-		return nil, fmt.Errorf("email cannot be empty")
+		return nil, shared.NewFieldError("email", "must not be empty")
 	}
 
 	return s.repository.GetUserByEmail(ctx, email)
@@ -83,7 +167,10 @@ func (s *Service) UpdateUser(ctx context.Context, user *User) (*User, error) {
 		return nil, fmt.Errorf("user cannot be nil")
 	}
 	if user.ID == "" {
-		return nil, fmt.Errorf("user ID cannot be empty")
+		return nil, shared.NewFieldError("user_id", "must not be empty")
+	}
+	if err := shared.Authorize(ctx, shared.ActionUpdateUser, user.ID); err != nil {
+		return nil, err
 	}
 
 	// Update user in repository
@@ -96,7 +183,7 @@ func (s *Service) UpdateUser(ctx context.Context, user *User) (*User, error) {
 	event := NewUserUpdatedEvent(updatedUser.ID, updatedUser.Username, updatedUser.Email)
 	if err := s.eventBus.Publish(ctx, event); err != nil {
 		// Log error but don't fail the operation
-		fmt.Printf("Warning: failed to publish user updated event: %v\n", err)
+		s.logger.WarnContext(ctx, "failed to publish user updated event", slog.Any("error", err))
 	}
 
 	return updatedUser, nil
@@ -104,7 +191,10 @@ func (s *Service) UpdateUser(ctx context.Context, user *User) (*User, error) {
 
 func (s *Service) DeleteUser(ctx context.Context, id string) error {
 	if id == "" {
-		return fmt.Errorf("user ID cannot be empty")
+		return shared.NewFieldError("user_id", "must not be empty")
+	}
+	if err := shared.Authorize(ctx, shared.ActionDeleteUser, id); err != nil {
+		return err
 	}
 
 	// Get user first to get username for event
@@ -113,30 +203,64 @@ func (s *Service) DeleteUser(ctx context.Context, id string) error {
 		return fmt.Errorf("failed to get user before deletion: %w", err)
 	}
 
-	// Delete user from repository
-	if err := s.repository.DeleteUser(ctx, id); err != nil {
-		return fmt.Errorf("failed to delete user: %w", err)
-	}
+	// Delete the user together with their watchlist, history and ratings, and
+	// record the deleted event in the outbox so it is only sent on commit
+	err = s.unitOfWork.Do(ctx, func(ctx context.Context, repos TxRepositories) error {
+		for _, userData := range repos.UserData {
+			if err := userData.DeleteUserData(ctx, id); err != nil {
+				return err
+			}
+		}
 
-	// Publish user deleted event
-	event := NewUserDeletedEvent(id, user.Username)
-	if err := s.eventBus.Publish(ctx, event); err != nil {
-		// Log error but don't fail the operation since user was deleted
-		fmt.Printf("Warning: failed to publish user deleted event: %v\n", err)
+		if err := repos.Users.DeleteUser(ctx, id); err != nil {
+			return err
+		}
+
+		return repos.Outbox.Add(ctx, NewUserDeletedEvent(id, user.Username))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete user: %w", err)
 	}
 
 	return nil
 }
 
-func (s *Service) ListUsers(ctx context.Context, limit, offset int) ([]*User, error) {
-	if limit <= 0 {
-		limit = 10 // Default limit
+// ChangeUserRole grants a user a role. Admins cannot change their own role, so
+// the last admin cannot lock everyone out by accident.
+func (s *Service) ChangeUserRole(ctx context.Context, id string, role shared.Role) (*User, error) {
+	if id == "" {
+		return nil, shared.NewFieldError("user_id", "must not be empty")
+	}
+	if !role.Valid() {
+		return nil, shared.NewFieldError("role", "must be one of user, curator or admin")
+	}
+	if err := shared.Authorize(ctx, shared.ActionChangeUserRole, ""); err != nil {
+		return nil, err
+	}
+	if userID, _ := shared.UserIDFromContext(ctx); userID == id {
+		return nil, shared.NewConflictError("admins cannot change their own role")
+	}
+
+	if err := s.repository.UpdateUserRole(ctx, id, role); err != nil {
+		return nil, err
 	}
-	if offset < 0 {
-		offset = 0
+	s.logger.InfoContext(ctx, "user role changed",
+		slog.Bool("audit", true),
+		slog.String("user_id", id),
+		slog.String("role", string(role)),
+	)
+
+	return s.repository.GetUserByID(ctx, id)
+}
+
+// ListUsers returns a page of users, newest first. Only admins may list
+// users.
+func (s *Service) ListUsers(ctx context.Context, page shared.PageRequest) (*shared.Page[*User], error) {
+	if err := shared.Authorize(ctx, shared.ActionListUsers, ""); err != nil {
+		return nil, err
 	}
 
-	return s.repository.ListUsers(ctx, limit, offset)
+	return s.repository.ListUsers(ctx, page.WithDefaults())
 }
 
 func (s *Service) GetActiveUserCount(ctx context.Context) (int64, error) {
@@ -147,6 +271,9 @@ func (s *Service) GetRecentUsers(ctx context.Context, limit int) ([]*User, error
 	if limit <= 0 {
 		limit = 10
 	}
+	if err := shared.Authorize(ctx, shared.ActionListUsers, ""); err != nil {
+		return nil, err
+	}
 
 	return s.repository.GetRecentUsers(ctx, limit)
 }
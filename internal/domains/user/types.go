@@ -1,19 +1,28 @@
 package user
 
 import (
-	"fmt"
 	"time"
 
+	"event-driven-go/internal/shared"
 	"gorm.io/gorm"
 )
 
+var (
+	ErrUserNotFound       = shared.NewNotFoundError("user")
+	ErrUserAlreadyExists  = shared.NewAlreadyExistsError("username or email is already taken")
+	ErrInvalidCredentials = shared.NewUnauthenticatedError("invalid username or password")
+)
+
 type User struct {
-	ID        string         `json:"id" db:"id" gorm:"primaryKey;type:varchar(36)"`
-	Username  string         `json:"username" db:"username" gorm:"type:varchar(100);not null;uniqueIndex"`
-	Email     string         `json:"email" db:"email" gorm:"type:varchar(255);not null;uniqueIndex"`
-	CreatedAt time.Time      `json:"created_at" db:"created_at" gorm:"autoCreateTime"`
-	UpdatedAt time.Time      `json:"updated_at" db:"updated_at" gorm:"autoUpdateTime"`
-	DeletedAt gorm.DeletedAt `gorm:"index"` // Soft delete support
+	ID       string `json:"id" db:"id" gorm:"primaryKey;type:varchar(36)"`
+	Username string `json:"username" db:"username" gorm:"type:varchar(100);not null;uniqueIndex"`
+	Email    string `json:"email" db:"email" gorm:"type:varchar(255);not null;uniqueIndex"`
+	// PasswordHash is a bcrypt hash, empty for users registered before passwords
+	PasswordHash string         `json:"-" db:"password_hash" gorm:"type:varchar(255);not null;default:''"`
+	Role         shared.Role    `json:"role" db:"role" gorm:"type:varchar(20);not null;default:'user'"`
+	CreatedAt    time.Time      `json:"created_at" db:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt    time.Time      `json:"updated_at" db:"updated_at" gorm:"autoUpdateTime"`
+	DeletedAt    gorm.DeletedAt `json:"-" gorm:"index"` // Soft delete support
 }
 
 func (User) TableName() string {
@@ -23,10 +32,10 @@ func (User) TableName() string {
 func (u *User) BeforeCreate(tx *gorm.DB) error {
 	// Add any user validation logic here
 	if u.Username == "" {
-		return fmt.Errorf("username cannot be empty")
+		return shared.NewFieldError("username", "must not be empty")
 	}
 	if u.Email == "" {
-		return fmt.Errorf("email cannot be empty")
+		return shared.NewFieldError("email", "must not be empty")
 	}
 	return nil
 }
@@ -3,11 +3,20 @@ package watchlist
 import "event-driven-go/internal/shared"
 
 const (
-	MovieAddedToWatchlistEventType = "watchlist_movie_added"
+	MovieAddedToWatchlistEventType     = "watchlist_movie_added"
+	MovieRemovedFromWatchlistEventType = "watchlist_movie_removed"
 )
 
 func init() {
-	shared.GlobalEventBus.RegisterEventType(MovieAddedToWatchlistEventType, &MovieAddedToWatchlistEvent{}, &Handler{})
+	RegisterEventHandler(shared.GlobalEventBus, NewHandler(shared.Logger, nil))
+}
+
+// RegisterEventHandler registers the watchlist events with the handler that
+// processes them. The application registers them again with a handler that
+// counts movie popularity.
+func RegisterEventHandler(eventBus *shared.EventBus, handler *Handler) {
+	eventBus.RegisterEventType(MovieAddedToWatchlistEventType, &MovieAddedToWatchlistEvent{}, handler)
+	eventBus.RegisterEventType(MovieRemovedFromWatchlistEventType, &MovieRemovedFromWatchlistEvent{}, handler)
 }
 
 type MovieAddedToWatchlistEvent struct {
@@ -37,3 +46,27 @@ func NewMovieAddedToWatchlistEvent(userID string, movieID string, title string)
 		Title:     title,
 	}
 }
+
+type MovieRemovedFromWatchlistEvent struct {
+	shared.BaseEvent
+	UserID  string `json:"user_id"`
+	MovieID string `json:"movie_id"`
+}
+
+func (e MovieRemovedFromWatchlistEvent) GetPayload() interface{} {
+	return struct {
+		UserID  string `json:"user_id"`
+		MovieID string `json:"movie_id"`
+	}{
+		UserID:  e.UserID,
+		MovieID: e.MovieID,
+	}
+}
+
+func NewMovieRemovedFromWatchlistEvent(userID string, movieID string) *MovieRemovedFromWatchlistEvent {
+	return &MovieRemovedFromWatchlistEvent{
+		BaseEvent: shared.NewBaseEvent(MovieRemovedFromWatchlistEventType),
+		UserID:    userID,
+		MovieID:   movieID,
+	}
+}
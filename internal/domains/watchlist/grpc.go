@@ -0,0 +1,101 @@
+package watchlist
+
+import (
+	"context"
+	"log/slog"
+
+	moviesdbv1 "event-driven-go/internal/gen/moviesdb/v1"
+	"event-driven-go/internal/shared"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// GRPCServer implements moviesdb.v1.WatchlistService on top of the service.
+type GRPCServer struct {
+	moviesdbv1.UnimplementedWatchlistServiceServer
+	service *Service
+	logger  *slog.Logger
+}
+
+func NewGRPCServer(service *Service, logger *slog.Logger) *GRPCServer {
+	return &GRPCServer{
+		service: service,
+		logger:  logger.With(slog.String("domain", "watchlist")),
+	}
+}
+
+func (s *GRPCServer) Register(server *grpc.Server) {
+	moviesdbv1.RegisterWatchlistServiceServer(server, s)
+}
+
+func (s *GRPCServer) GetWatchlist(ctx context.Context, req *moviesdbv1.GetWatchlistRequest) (*moviesdbv1.GetWatchlistResponse, error) {
+	if _, err := shared.AuthenticatedUserID(ctx); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.service.GetWatchlist(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &moviesdbv1.GetWatchlistResponse{Entries: make([]*moviesdbv1.WatchlistEntry, 0, len(entries))}
+	for _, entry := range entries {
+		response.Entries = append(response.Entries, toProtoEntry(entry))
+	}
+
+	return response, nil
+}
+
+func (s *GRPCServer) AddToWatchlist(ctx context.Context, req *moviesdbv1.AddToWatchlistRequest) (*moviesdbv1.WatchlistEntry, error) {
+	if _, err := shared.AuthenticatedUserID(ctx); err != nil {
+		return nil, err
+	}
+
+	entry, err := s.service.AddMovie(ctx, req.GetUserId(), req.GetMovieId(), req.GetNotes())
+	if err != nil {
+		return nil, err
+	}
+
+	return toProtoEntry(entry), nil
+}
+
+func (s *GRPCServer) RemoveFromWatchlist(ctx context.Context, req *moviesdbv1.RemoveFromWatchlistRequest) (*emptypb.Empty, error) {
+	if _, err := shared.AuthenticatedUserID(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := s.service.RemoveMovie(ctx, req.GetUserId(), req.GetMovieId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *GRPCServer) AddCollectionToWatchlist(ctx context.Context, req *moviesdbv1.AddCollectionToWatchlistRequest) (*moviesdbv1.AddCollectionToWatchlistResponse, error) {
+	if _, err := shared.AuthenticatedUserID(ctx); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.service.AddCollection(ctx, req.GetUserId(), req.GetCollectionId())
+	if err != nil {
+		return nil, err
+	}
+
+	response := &moviesdbv1.AddCollectionToWatchlistResponse{Entries: make([]*moviesdbv1.WatchlistEntry, 0, len(entries))}
+	for _, entry := range entries {
+		response.Entries = append(response.Entries, toProtoEntry(entry))
+	}
+
+	return response, nil
+}
+
+func toProtoEntry(entry *WatchlistEntry) *moviesdbv1.WatchlistEntry {
+	return &moviesdbv1.WatchlistEntry{
+		Id:      entry.ID,
+		UserId:  entry.UserID,
+		MovieId: entry.MovieID,
+		Notes:   entry.Notes,
+		AddedAt: timestamppb.New(entry.AddedAt),
+	}
+}
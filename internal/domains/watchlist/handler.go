@@ -2,32 +2,77 @@ package watchlist
 
 import (
 	"context"
+	"event-driven-go/internal/domains/movies"
 	"event-driven-go/internal/shared"
 	"fmt"
-	"log"
+	"log/slog"
 )
 
-type Handler struct{}
+// Handler counts watchlist entries towards the popularity of movies, when
+// given a counter.
+type Handler struct {
+	popularity movies.PopularityCounter
+	logger     *slog.Logger
+}
+
+func NewHandler(logger *slog.Logger, popularity movies.PopularityCounter) *Handler {
+	return &Handler{
+		popularity: popularity,
+		logger:     logger.With(slog.String("domain", "watchlist")),
+	}
+}
 
 func (h *Handler) Handle(ctx context.Context, event shared.Event) error {
 	switch e := event.(type) {
 	case *MovieAddedToWatchlistEvent:
 		return h.handleMovieAddedToWatchlist(ctx, e)
+	case *MovieRemovedFromWatchlistEvent:
+		return h.handleMovieRemovedFromWatchlist(ctx, e)
 	default:
 		return fmt.Errorf("unsupported event type: %T", event)
 	}
 }
 
 func (h *Handler) CanHandle(eventType string) bool {
-	return eventType == MovieAddedToWatchlistEventType
+	return eventType == MovieAddedToWatchlistEventType || eventType == MovieRemovedFromWatchlistEventType
 }
 
 func (h *Handler) handleMovieAddedToWatchlist(ctx context.Context, event *MovieAddedToWatchlistEvent) error {
-	log.Printf("🎬 WATCHLIST: User %s added '%s' to watchlist",
-		event.UserID, event.Title)
+	h.logger.InfoContext(ctx, "user added movie to watchlist",
+		slog.String("user_id", event.UserID),
+		slog.String("movie_id", event.MovieID),
+		slog.String("title", event.Title),
+	)
+
+	if err := h.addPopularity(ctx, event.MovieID, 1); err != nil {
+		return err
+	}
+
+	h.logger.DebugContext(ctx, "successfully processed watchlist event")
+	return nil
+}
 
-	// todo handle with service
+func (h *Handler) handleMovieRemovedFromWatchlist(ctx context.Context, event *MovieRemovedFromWatchlistEvent) error {
+	h.logger.InfoContext(ctx, "user removed movie from watchlist",
+		slog.String("user_id", event.UserID),
+		slog.String("movie_id", event.MovieID),
+	)
 
-	log.Printf("✅ WATCHLIST: Successfully processed event %s", event.GetID())
+	if err := h.addPopularity(ctx, event.MovieID, -1); err != nil {
+		return err
+	}
+
+	h.logger.DebugContext(ctx, "successfully processed watchlist event")
+	return nil
+}
+
+func (h *Handler) addPopularity(ctx context.Context, movieID string, delta int) error {
+	if h.popularity == nil {
+		return nil
+	}
+
+	if err := h.popularity.AddPopularity(ctx, movieID, delta); err != nil {
+		return fmt.Errorf("failed to count movie popularity: %w", err)
+	}
 	return nil
 }
@@ -0,0 +1,156 @@
+package watchlist
+
+import (
+	"log/slog"
+	"net/http"
+
+	"event-driven-go/internal/shared"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const maxNotesLength = 2000
+
+type HTTPHandler struct {
+	service *Service
+	logger  *slog.Logger
+}
+
+func NewHTTPHandler(service *Service, logger *slog.Logger) *HTTPHandler {
+	return &HTTPHandler{
+		service: service,
+		logger:  logger.With(slog.String("domain", "watchlist")),
+	}
+}
+
+func (h *HTTPHandler) RegisterRoutes(router *shared.Router) {
+	router.Handle(shared.Route{
+		Method:   http.MethodGet,
+		Path:     "/users/{id}/watchlist",
+		Tag:      "watchlist",
+		Summary:  "A user's watchlist, most recently added first",
+		Response: WatchlistResponse{},
+		Errors:   []int{http.StatusForbidden, http.StatusNotFound},
+		Auth:     true,
+	}, h.getWatchlist)
+	router.Handle(shared.Route{
+		Method:   http.MethodPost,
+		Path:     "/users/{id}/watchlist",
+		Tag:      "watchlist",
+		Summary:  "Add a movie to the watchlist, replacing the notes if it is already listed",
+		Request:  AddMovieRequest{},
+		Response: WatchlistEntry{},
+		Status:   http.StatusCreated,
+		Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusUnprocessableEntity},
+		Auth:     true,
+	}, h.addMovie)
+	router.Handle(shared.Route{
+		Method:   http.MethodPost,
+		Path:     "/users/{id}/watchlist/collections",
+		Tag:      "watchlist",
+		Summary:  "Add the movies of a collection not yet watched or listed, in order",
+		Request:  AddCollectionRequest{},
+		Response: WatchlistResponse{},
+		Errors:   []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound, http.StatusUnprocessableEntity},
+		Auth:     true,
+	}, h.addCollection)
+	router.Handle(shared.Route{
+		Method:  http.MethodDelete,
+		Path:    "/users/{id}/watchlist/{movieID}",
+		Tag:     "watchlist",
+		Summary: "Remove a movie from the watchlist",
+		Status:  http.StatusNoContent,
+		Errors:  []int{http.StatusBadRequest, http.StatusForbidden, http.StatusNotFound},
+		Auth:    true,
+	}, h.removeMovie)
+}
+
+type AddMovieRequest struct {
+	MovieID string `json:"movie_id"`
+	Notes   string `json:"notes,omitempty"`
+}
+
+type AddCollectionRequest struct {
+	CollectionID string `json:"collection_id" openapi:"required"`
+}
+
+type WatchlistResponse struct {
+	Data []*WatchlistEntry `json:"data"`
+}
+
+func (h *HTTPHandler) getWatchlist(w http.ResponseWriter, r *http.Request) {
+	entries, err := h.service.GetWatchlist(r.Context(), r.PathValue("id"))
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+	if entries == nil {
+		entries = []*WatchlistEntry{}
+	}
+
+	shared.WriteJSON(w, http.StatusOK, WatchlistResponse{Data: entries})
+}
+
+func (h *HTTPHandler) addMovie(w http.ResponseWriter, r *http.Request) {
+	var request AddMovieRequest
+	if err := shared.DecodeJSON(w, r, &request); err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	fields := make(map[string]string)
+	if !primitive.IsValidObjectID(request.MovieID) {
+		fields["movie_id"] = "must be a 24 character hex ObjectID"
+	}
+	if len(request.Notes) > maxNotesLength {
+		fields["notes"] = "must be at most 2000 characters"
+	}
+	if len(fields) > 0 {
+		shared.WriteError(w, r, http.StatusUnprocessableEntity, "invalid watchlist entry", fields)
+		return
+	}
+
+	entry, err := h.service.AddMovie(r.Context(), r.PathValue("id"), request.MovieID, request.Notes)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusCreated, entry)
+}
+
+// addCollection answers with the entries it added, which are none when the
+// user has watched or listed every movie of the collection.
+func (h *HTTPHandler) addCollection(w http.ResponseWriter, r *http.Request) {
+	var request AddCollectionRequest
+	if err := shared.DecodeJSON(w, r, &request); err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if !primitive.IsValidObjectID(request.CollectionID) {
+		shared.WriteError(w, r, http.StatusUnprocessableEntity, "invalid watchlist collection",
+			map[string]string{"collection_id": "must be a 24 character hex ObjectID"})
+		return
+	}
+
+	entries, err := h.service.AddCollection(r.Context(), r.PathValue("id"), request.CollectionID)
+	if err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	shared.WriteJSON(w, http.StatusOK, WatchlistResponse{Data: entries})
+}
+
+func (h *HTTPHandler) removeMovie(w http.ResponseWriter, r *http.Request) {
+	movieID, ok := shared.PathObjectID(w, r, "movieID")
+	if !ok {
+		return
+	}
+
+	if err := h.service.RemoveMovie(r.Context(), r.PathValue("id"), movieID); err != nil {
+		shared.WriteServiceError(w, r, h.logger, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
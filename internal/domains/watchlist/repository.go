@@ -3,18 +3,26 @@ package watchlist
 import (
 	"context"
 	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type Repository struct {
-	db *gorm.DB
+	db     *gorm.DB
+	logger *slog.Logger
 }
 
-func NewRepository(db *gorm.DB) *Repository {
-	return &Repository{db: db}
+func NewRepository(db *gorm.DB, logger *slog.Logger) *Repository {
+	return &Repository{
+		db:     db,
+		logger: logger.With(slog.String("repository", "watchlist")),
+	}
 }
 
 func (r *Repository) AddToWatchlist(ctx context.Context, userID, movieID string, notes string) (*WatchlistEntry, error) {
@@ -26,37 +34,67 @@ func (r *Repository) AddToWatchlist(ctx context.Context, userID, movieID string,
 		Notes:   notes,
 	}
 
-	// GORM will handle the upsert with Clauses
+	// Upsert on idx_watchlist_entries_user_movie rather than retrying after a
+	// duplicate key error, which would abort an enclosing unit of work
 	result := r.db.WithContext(ctx).
-		Clauses().
+		Clauses(
+			clause.OnConflict{
+				Columns:     []clause.Column{{Name: "user_id"}, {Name: "movie_id"}},
+				TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "deleted_at IS NULL"}}},
+				DoUpdates:   clause.AssignmentColumns([]string{"notes", "added_at", "updated_at"}),
+			},
+			clause.Returning{},
+		).
 		Create(entry)
 
 	if result.Error != nil {
-		// Handle unique constraint violation (user already has this movie in watchlist)
-		if isDuplicateKeyError(result.Error) {
-			// Update existing entry
-			var existing WatchlistEntry
-			if err := r.db.WithContext(ctx).
-				Where("user_id = ? AND movie_id = ?", userID, movieID).
-				First(&existing).Error; err != nil {
-				return nil, fmt.Errorf("failed to find existing entry: %w", err)
-			}
-
-			existing.Notes = notes
-			existing.AddedAt = time.Now()
-
-			if err := r.db.WithContext(ctx).Save(&existing).Error; err != nil {
-				return nil, fmt.Errorf("failed to update existing entry: %w", err)
-			}
-
-			return &existing, nil
-		}
 		return nil, fmt.Errorf("failed to add to watchlist: %w", result.Error)
 	}
+	r.logger.DebugContext(ctx, "movie added to watchlist",
+		slog.String("user_id", userID),
+		slog.String("movie_id", movieID),
+	)
 
 	return entry, nil
 }
 
+// AddAllToWatchlist lists the movies in one statement, skipping those already
+// listed, and returns the entries it added, in the given order. The first
+// movie is added at addedAt and each next one a millisecond earlier, so that
+// the watchlist, newest first, shows them in the given order.
+func (r *Repository) AddAllToWatchlist(ctx context.Context, userID string, movieIDs []string, addedAt time.Time) ([]*WatchlistEntry, error) {
+	entries := []*WatchlistEntry{}
+	if len(movieIDs) == 0 {
+		return entries, nil
+	}
+
+	now := time.Now()
+	rows := make([]any, 0, len(movieIDs))
+	for i, movieID := range movieIDs {
+		rows = append(rows, []any{uuid.New().String(), userID, movieID, addedAt.Add(-time.Duration(i) * time.Millisecond), "", now, now})
+	}
+
+	// RETURNING yields only the rows inserted, as a conflicting row is
+	// skipped; Create would scan them into the wrong entries
+	err := r.db.WithContext(ctx).Raw(`INSERT INTO watchlist_entries (id, user_id, movie_id, added_at, notes, created_at, updated_at)
+		VALUES `+strings.Repeat("?, ", len(rows)-1)+`?
+		ON CONFLICT (user_id, movie_id) WHERE deleted_at IS NULL DO NOTHING
+		RETURNING *`, rows...).
+		Scan(&entries).Error
+	if err != nil {
+		return nil, fmt.Errorf("failed to add to watchlist: %w", err)
+	}
+	slices.SortFunc(entries, func(a, b *WatchlistEntry) int {
+		return b.AddedAt.Compare(a.AddedAt)
+	})
+	r.logger.DebugContext(ctx, "movies added to watchlist",
+		slog.String("user_id", userID),
+		slog.Int("rows", len(entries)),
+	)
+
+	return entries, nil
+}
+
 func (r *Repository) RemoveFromWatchlist(ctx context.Context, userID, movieID string) error {
 	result := r.db.WithContext(ctx).
 		Where("user_id = ? AND movie_id = ?", userID, movieID).
@@ -67,7 +105,7 @@ func (r *Repository) RemoveFromWatchlist(ctx context.Context, userID, movieID st
 	}
 
 	if result.RowsAffected == 0 {
-		return fmt.Errorf("movie not found in watchlist")
+		return ErrNotInWatchlist
 	}
 
 	return nil
@@ -103,12 +141,47 @@ func (r *Repository) IsInWatchlist(ctx context.Context, userID, movieID string)
 	return count > 0, nil
 }
 
-func (r *Repository) AutoMigrate() error {
-	return r.db.AutoMigrate(&WatchlistEntry{})
+// DeleteUserData removes all watchlist entries belonging to a user.
+func (r *Repository) DeleteUserData(ctx context.Context, userID string) error {
+	result := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Delete(&WatchlistEntry{})
+
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete user watchlist entries: %w", result.Error)
+	}
+	r.logger.DebugContext(ctx, "user watchlist entries deleted",
+		slog.String("user_id", userID),
+		slog.Int64("rows", result.RowsAffected),
+	)
+
+	return nil
 }
 
-func isDuplicateKeyError(err error) bool {
-	// PostgreSQL duplicate key error contains "duplicate key value violates unique constraint"
-	// You might want to use a more robust error type checking here
-	return err != nil && gorm.ErrDuplicatedKey == err
+// MergeMovieReferences moves the watchlist entries of merged movies to the
+// surviving movie. A user who listed several of them keeps the most recently
+// added entry, as idx_watchlist_entries_user_movie allows only one.
+func (r *Repository) MergeMovieReferences(ctx context.Context, survivorID string, duplicateIDs []string) error {
+	db := r.db.WithContext(ctx)
+
+	ranked := db.Model(&WatchlistEntry{}).
+		Select("id, row_number() OVER (PARTITION BY user_id ORDER BY added_at DESC) AS position").
+		Where("movie_id IN ?", append([]string{survivorID}, duplicateIDs...))
+	superseded := db.Table("(?) AS ranked", ranked).Select("id").Where("position > 1")
+	if err := db.Where("id IN (?)", superseded).Delete(&WatchlistEntry{}).Error; err != nil {
+		return fmt.Errorf("failed to remove superseded watchlist entries: %w", err)
+	}
+
+	result := db.Unscoped().Model(&WatchlistEntry{}).
+		Where("movie_id IN ?", duplicateIDs).
+		Update("movie_id", survivorID)
+	if result.Error != nil {
+		return fmt.Errorf("failed to move watchlist entries: %w", result.Error)
+	}
+	r.logger.DebugContext(ctx, "watchlist entries moved to merged movie",
+		slog.String("movie_id", survivorID),
+		slog.Int64("rows", result.RowsAffected),
+	)
+
+	return nil
 }
@@ -2,58 +2,188 @@ package watchlist
 
 import (
 	"context"
-	"fmt"
+	"log/slog"
+	"slices"
+	"time"
 
+	"event-driven-go/internal/domains/movies"
+	"event-driven-go/internal/domains/user"
 	"event-driven-go/internal/shared"
 	schema "github.com/nameteos/my-movies-db-schema/mongodb"
 )
 
+// UserLookup and MovieLookup resolve the user and movie a watchlist entry
+// refers to; both live in other domains. HistoryLookup tells the movies a
+// user has already seen.
+type UserLookup interface {
+	GetUserByID(ctx context.Context, id string) (*user.User, error)
+}
+
+type MovieLookup interface {
+	GetMovieByID(ctx context.Context, id string) (*schema.Movie, error)
+	GetCollection(ctx context.Context, id string) (*movies.Collection, error)
+}
+
+type HistoryLookup interface {
+	WatchedMovieIDs(ctx context.Context, userID string, movieIDs []string) ([]string, error)
+}
+
 type Service struct {
-	eventBus *shared.EventBus
+	repository *Repository
+	users      UserLookup
+	movies     MovieLookup
+	history    HistoryLookup
+	eventBus   *shared.EventBus
+	logger     *slog.Logger
 }
 
-func NewService(eventBus *shared.EventBus) *Service {
+func NewService(repository *Repository, users UserLookup, movies MovieLookup, history HistoryLookup, eventBus *shared.EventBus, logger *slog.Logger) *Service {
 	return &Service{
-		eventBus: eventBus,
+		repository: repository,
+		users:      users,
+		movies:     movies,
+		history:    history,
+		eventBus:   eventBus,
+		logger:     logger.With(slog.String("domain", "watchlist")),
 	}
 }
 
-func (s *Service) AddMovie(ctx context.Context, userID string, movie *schema.Movie) error {
+// AddMovie puts a movie on a user's watchlist. Adding a movie that is already
+// listed replaces its notes.
+func (s *Service) AddMovie(ctx context.Context, userID, movieID, notes string) (*WatchlistEntry, error) {
 	// Validate input
 	if userID == "" {
-		return fmt.Errorf("user ID cannot be empty")
+		return nil, shared.NewFieldError("user_id", "must not be empty")
 	}
-	if movie == nil {
-		return fmt.Errorf("movie cannot be nil")
+	if movieID == "" {
+		return nil, shared.NewFieldError("movie_id", "must not be empty")
+	}
+	if err := shared.Authorize(ctx, shared.ActionModifyUserData, userID); err != nil {
+		return nil, err
 	}
 
-	// todo logic
-
-	movieID := movie.ID.Hex() // Convert ObjectID to string
+	if _, err := s.users.GetUserByID(ctx, userID); err != nil {
+		return nil, err
+	}
+	movie, err := s.movies.GetMovieByID(ctx, movieID)
+	if err != nil {
+		return nil, err
+	}
 
-	event := NewMovieAddedToWatchlistEvent(
-		userID,
-		movieID,
-		movie.Title,
-	)
+	entry, err := s.repository.AddToWatchlist(ctx, userID, movieID, notes)
+	if err != nil {
+		return nil, err
+	}
 
+	event := NewMovieAddedToWatchlistEvent(userID, movieID, movie.Title)
 	if err := s.eventBus.Publish(ctx, event); err != nil {
-		return fmt.Errorf("failed to publish watchlist event: %w", err)
+		// Log error but don't fail the operation since the entry was stored
+		s.logger.WarnContext(ctx, "failed to publish watchlist event", slog.Any("error", err))
 	}
 
-	return nil
+	return entry, nil
+}
+
+// AddCollection puts the movies of a collection the user has neither watched
+// nor listed yet on their watchlist, in the order of the collection. It
+// returns the entries it added.
+func (s *Service) AddCollection(ctx context.Context, userID, collectionID string) ([]*WatchlistEntry, error) {
+	if userID == "" {
+		return nil, shared.NewFieldError("user_id", "must not be empty")
+	}
+	if collectionID == "" {
+		return nil, shared.NewFieldError("collection_id", "must not be empty")
+	}
+	if err := shared.Authorize(ctx, shared.ActionModifyUserData, userID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.users.GetUserByID(ctx, userID); err != nil {
+		return nil, err
+	}
+	collection, err := s.movies.GetCollection(ctx, collectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	movieIDs := make([]string, 0, len(collection.Movies))
+	titles := make(map[string]string, len(collection.Movies))
+	for _, movie := range collection.Movies {
+		movieIDs = append(movieIDs, movie.ID.Hex())
+		titles[movie.ID.Hex()] = movie.Title
+	}
+	watched, err := s.history.WatchedMovieIDs(ctx, userID, movieIDs)
+	if err != nil {
+		return nil, err
+	}
+	listed, err := s.repository.GetUserWatchlist(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	movieIDs = slices.DeleteFunc(movieIDs, func(movieID string) bool {
+		return slices.Contains(watched, movieID) || slices.ContainsFunc(listed, func(entry *WatchlistEntry) bool {
+			return entry.MovieID == movieID
+		})
+	})
+
+	entries, err := s.repository.AddAllToWatchlist(ctx, userID, movieIDs, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	// A movie listed meanwhile by another request is not among the entries,
+	// so it is not announced twice
+	for _, entry := range entries {
+		event := NewMovieAddedToWatchlistEvent(userID, entry.MovieID, titles[entry.MovieID])
+		if err := s.eventBus.Publish(ctx, event); err != nil {
+			s.logger.WarnContext(ctx, "failed to publish watchlist event", slog.Any("error", err))
+		}
+	}
+
+	return entries, nil
 }
 
 // RemoveMovie removes a movie from a user's watchlist
 func (s *Service) RemoveMovie(ctx context.Context, userID, movieID string) error {
 	// Validate input
 	if userID == "" {
-		return fmt.Errorf("user ID cannot be empty")
+		return shared.NewFieldError("user_id", "must not be empty")
 	}
 	if movieID == "" {
-		return fmt.Errorf("movie ID cannot be empty")
+		return shared.NewFieldError("movie_id", "must not be empty")
+	}
+	if err := shared.Authorize(ctx, shared.ActionModifyUserData, userID); err != nil {
+		return err
+	}
+
+	if _, err := s.users.GetUserByID(ctx, userID); err != nil {
+		return err
+	}
+
+	// The movie itself may already be gone from the catalog, so it is not looked up
+	if err := s.repository.RemoveFromWatchlist(ctx, userID, movieID); err != nil {
+		return err
+	}
+
+	event := NewMovieRemovedFromWatchlistEvent(userID, movieID)
+	if err := s.eventBus.Publish(ctx, event); err != nil {
+		s.logger.WarnContext(ctx, "failed to publish watchlist event", slog.Any("error", err))
 	}
 
-	// TODO: Implement removal logic and event
 	return nil
 }
+
+func (s *Service) GetWatchlist(ctx context.Context, userID string) ([]*WatchlistEntry, error) {
+	if userID == "" {
+		return nil, shared.NewFieldError("user_id", "must not be empty")
+	}
+	if err := shared.Authorize(ctx, shared.ActionReadUserData, userID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.users.GetUserByID(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	return s.repository.GetUserWatchlist(ctx, userID)
+}
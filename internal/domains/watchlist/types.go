@@ -3,9 +3,12 @@ package watchlist
 import (
 	"time"
 
+	"event-driven-go/internal/shared"
 	"gorm.io/gorm"
 )
 
+var ErrNotInWatchlist = shared.NewNotFoundError("watchlist entry")
+
 type WatchlistEntry struct {
 	ID      string    `json:"id" db:"id" gorm:"primaryKey;type:varchar(36)"`
 	UserID  string    `json:"user_id" db:"user_id" gorm:"type:varchar(36);not null;index"`
@@ -14,9 +17,9 @@ type WatchlistEntry struct {
 	Notes   string    `json:"notes" db:"notes" gorm:"type:text"`
 
 	// GORM fields
-	CreatedAt time.Time      `gorm:"autoCreateTime"`
-	UpdatedAt time.Time      `gorm:"autoUpdateTime"`
-	DeletedAt gorm.DeletedAt `gorm:"index"` // Soft delete support
+	CreatedAt time.Time      `json:"created_at" gorm:"autoCreateTime"`
+	UpdatedAt time.Time      `json:"updated_at" gorm:"autoUpdateTime"`
+	DeletedAt gorm.DeletedAt `json:"-" gorm:"index"` // Soft delete support
 }
 
 func (WatchlistEntry) TableName() string {
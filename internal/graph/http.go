@@ -0,0 +1,84 @@
+package graph
+
+import (
+	_ "embed"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"event-driven-go/internal/shared"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/graph-gophers/graphql-go/errors"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+const (
+	maxQueryDepth = 10
+	// maxParallelism lets a whole page of list items resolve at once, so their
+	// lookups land in the same loader batch.
+	maxParallelism = shared.MaxPageLimit
+)
+
+// HTTPHandler serves the GraphQL schema at /graphql.
+type HTTPHandler struct {
+	schema   *graphql.Schema
+	services Services
+	logger   *slog.Logger
+}
+
+func NewHTTPHandler(services Services, logger *slog.Logger) *HTTPHandler {
+	logger = logger.With(slog.String("component", "graphql"))
+
+	return &HTTPHandler{
+		schema: graphql.MustParseSchema(schemaSDL, &queryResolver{services: services, logger: logger},
+			graphql.MaxDepth(maxQueryDepth),
+			graphql.MaxParallelism(maxParallelism),
+		),
+		services: services,
+		logger:   logger,
+	}
+}
+
+func (h *HTTPHandler) RegisterRoutes(router *shared.Router) {
+	router.Handle(shared.Route{
+		Method:   http.MethodPost,
+		Path:     "/graphql",
+		Tag:      "graphql",
+		Summary:  "Run a GraphQL query; query errors are reported in the errors field of a 200 response",
+		Request:  QueryRequest{},
+		Response: QueryResponse{},
+		Errors:   []int{http.StatusBadRequest},
+	}, h.serveQuery)
+}
+
+type QueryRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+type QueryResponse struct {
+	Data   json.RawMessage      `json:"data,omitempty"`
+	Errors []*errors.QueryError `json:"errors,omitempty"`
+}
+
+func (h *HTTPHandler) serveQuery(w http.ResponseWriter, r *http.Request) {
+	var request QueryRequest
+	if err := shared.DecodeJSON(w, r, &request); err != nil {
+		shared.WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+	if strings.TrimSpace(request.Query) == "" {
+		shared.WriteError(w, r, http.StatusBadRequest, "query must not be empty", nil)
+		return
+	}
+
+	// Loaders live for one request, their cache must not outlast it
+	ctx := contextWithLoaders(r.Context(), newLoaders(h.services))
+	response := h.schema.Exec(ctx, request.Query, request.OperationName, request.Variables)
+
+	shared.WriteJSON(w, http.StatusOK, QueryResponse{Data: response.Data, Errors: response.Errors})
+}
@@ -0,0 +1,147 @@
+package graph
+
+import (
+	"context"
+	"time"
+
+	"event-driven-go/internal/domains/movies"
+	"event-driven-go/internal/domains/rating"
+	"event-driven-go/internal/domains/user"
+	"github.com/graph-gophers/dataloader/v7"
+	schema "github.com/nameteos/my-movies-db-schema/mongodb"
+)
+
+// batchWait is how long a loader collects keys before running its batch.
+// Sibling list items are resolved concurrently, so a page arrives well within it.
+const batchWait = 5 * time.Millisecond
+
+// ratingKey identifies the rating a user gave a movie.
+type ratingKey struct {
+	userID  string
+	movieID string
+}
+
+// loaders batch and cache the lookups of one request, so that resolving the
+// movies of a list takes a single $in query instead of one query per item.
+type loaders struct {
+	movies    *dataloader.Loader[string, *schema.Movie]
+	users     *dataloader.Loader[string, *user.User]
+	ratings   *dataloader.Loader[ratingKey, *rating.MovieRating]
+	summaries *dataloader.Loader[string, *rating.RatingSummary]
+}
+
+func newLoaders(s Services) *loaders {
+	return &loaders{
+		movies:    dataloader.NewBatchedLoader(s.loadMovies, dataloader.WithWait[string, *schema.Movie](batchWait)),
+		users:     dataloader.NewBatchedLoader(s.loadUsers, dataloader.WithWait[string, *user.User](batchWait)),
+		ratings:   dataloader.NewBatchedLoader(s.loadRatings, dataloader.WithWait[ratingKey, *rating.MovieRating](batchWait)),
+		summaries: dataloader.NewBatchedLoader(s.loadSummaries, dataloader.WithWait[string, *rating.RatingSummary](batchWait)),
+	}
+}
+
+type loadersKey struct{}
+
+func contextWithLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+func (s Services) loadMovies(ctx context.Context, ids []string) []*dataloader.Result[*schema.Movie] {
+	found, err := s.Movies.GetMoviesByIDs(ctx, ids)
+	if err != nil {
+		return failedResults[*schema.Movie](len(ids), err)
+	}
+
+	byID := make(map[string]*schema.Movie, len(found))
+	for _, movie := range found {
+		byID[movie.ID.Hex()] = movie
+	}
+
+	results := make([]*dataloader.Result[*schema.Movie], len(ids))
+	for i, id := range ids {
+		if movie, ok := byID[id]; ok {
+			results[i] = &dataloader.Result[*schema.Movie]{Data: movie}
+		} else {
+			results[i] = &dataloader.Result[*schema.Movie]{Error: movies.ErrMovieNotFound}
+		}
+	}
+
+	return results
+}
+
+func (s Services) loadUsers(ctx context.Context, ids []string) []*dataloader.Result[*user.User] {
+	found, err := s.Users.GetUsersByIDs(ctx, ids)
+	if err != nil {
+		return failedResults[*user.User](len(ids), err)
+	}
+
+	byID := make(map[string]*user.User, len(found))
+	for _, u := range found {
+		byID[u.ID] = u
+	}
+
+	results := make([]*dataloader.Result[*user.User], len(ids))
+	for i, id := range ids {
+		if u, ok := byID[id]; ok {
+			results[i] = &dataloader.Result[*user.User]{Data: u}
+		} else {
+			results[i] = &dataloader.Result[*user.User]{Error: user.ErrUserNotFound}
+		}
+	}
+
+	return results
+}
+
+// loadRatings runs one query per user in the batch; a request usually asks
+// about a single user's ratings.
+func (s Services) loadRatings(ctx context.Context, keys []ratingKey) []*dataloader.Result[*rating.MovieRating] {
+	movieIDsByUser := make(map[string][]string)
+	for _, key := range keys {
+		movieIDsByUser[key.userID] = append(movieIDsByUser[key.userID], key.movieID)
+	}
+
+	found := make(map[ratingKey]*rating.MovieRating, len(keys))
+	for userID, movieIDs := range movieIDsByUser {
+		ratings, err := s.Ratings.GetUserRatingsForMovies(ctx, userID, movieIDs)
+		if err != nil {
+			return failedResults[*rating.MovieRating](len(keys), err)
+		}
+		for _, r := range ratings {
+			found[ratingKey{userID: r.UserID, movieID: r.MovieID}] = r
+		}
+	}
+
+	// A movie the user did not rate resolves to nil rather than an error
+	results := make([]*dataloader.Result[*rating.MovieRating], len(keys))
+	for i, key := range keys {
+		results[i] = &dataloader.Result[*rating.MovieRating]{Data: found[key]}
+	}
+
+	return results
+}
+
+func (s Services) loadSummaries(ctx context.Context, movieIDs []string) []*dataloader.Result[*rating.RatingSummary] {
+	summaries, err := s.Ratings.GetRatingSummaries(ctx, movieIDs)
+	if err != nil {
+		return failedResults[*rating.RatingSummary](len(movieIDs), err)
+	}
+
+	// GetRatingSummaries answers in the order of movieIDs
+	results := make([]*dataloader.Result[*rating.RatingSummary], len(movieIDs))
+	for i, summary := range summaries {
+		results[i] = &dataloader.Result[*rating.RatingSummary]{Data: summary}
+	}
+
+	return results
+}
+
+func failedResults[V any](n int, err error) []*dataloader.Result[V] {
+	results := make([]*dataloader.Result[V], n)
+	for i := range results {
+		results[i] = &dataloader.Result[V]{Error: err}
+	}
+	return results
+}
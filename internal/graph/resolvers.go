@@ -0,0 +1,474 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"event-driven-go/internal/domains/library"
+	"event-driven-go/internal/domains/movies"
+	"event-driven-go/internal/domains/rating"
+	"event-driven-go/internal/domains/user"
+	"event-driven-go/internal/domains/watchlist"
+	"event-driven-go/internal/shared"
+	"github.com/graph-gophers/graphql-go"
+	schema "github.com/nameteos/my-movies-db-schema/mongodb"
+)
+
+// Services are the domain services the resolvers delegate to.
+type Services struct {
+	Users     *user.Service
+	Movies    *movies.Service
+	Watchlist *watchlist.Service
+	Library   *library.Service
+	Ratings   *rating.Service
+}
+
+// queryResolver resolves the Query root and carries what the type resolvers
+// below need.
+type queryResolver struct {
+	services Services
+	logger   *slog.Logger
+}
+
+type pageArgs struct {
+	Limit  *int32
+	Offset *int32
+}
+
+func (a pageArgs) bounds() (int, int, error) {
+	var limit, offset int32
+	if a.Limit != nil {
+		limit = *a.Limit
+	}
+	if a.Offset != nil {
+		offset = *a.Offset
+	}
+	return shared.ValidatePagination(limit, offset)
+}
+
+// page is the offset based page the arguments select. The lists of this
+// schema are plain arrays, so there is no place for cursors.
+func (a pageArgs) page() (shared.PageRequest, error) {
+	limit, offset, err := a.bounds()
+	return shared.PageRequest{Limit: limit, Offset: offset}, err
+}
+
+func (q *queryResolver) User(ctx context.Context, args struct{ ID graphql.ID }) (*userResolver, error) {
+	u, err := q.services.Users.GetUser(ctx, string(args.ID))
+	if errors.Is(err, shared.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, q.fail(ctx, err)
+	}
+
+	return &userResolver{q: q, user: u}, nil
+}
+
+func (q *queryResolver) UserByUsername(ctx context.Context, args struct{ Username string }) (*userResolver, error) {
+	u, err := q.services.Users.GetUserByUsername(ctx, args.Username)
+	if errors.Is(err, shared.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, q.fail(ctx, err)
+	}
+
+	return &userResolver{q: q, user: u}, nil
+}
+
+func (q *queryResolver) Users(ctx context.Context, args pageArgs) ([]*userResolver, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, q.fail(ctx, err)
+	}
+
+	users, err := q.services.Users.ListUsers(ctx, page)
+	if err != nil {
+		return nil, q.fail(ctx, err)
+	}
+
+	resolvers := make([]*userResolver, 0, len(users.Items))
+	for _, u := range users.Items {
+		resolvers = append(resolvers, &userResolver{q: q, user: u})
+	}
+	return resolvers, nil
+}
+
+func (q *queryResolver) Movie(ctx context.Context, args struct{ ID graphql.ID }) (*movieResolver, error) {
+	movie, err := q.services.Movies.GetMovieByID(ctx, string(args.ID))
+	if errors.Is(err, shared.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, q.fail(ctx, err)
+	}
+
+	return &movieResolver{q: q, movie: movie}, nil
+}
+
+type moviesArgs struct {
+	Query    *string
+	Genre    *string
+	Year     *int32
+	Director *string
+	pageArgs
+}
+
+func (q *queryResolver) Movies(ctx context.Context, args moviesArgs) ([]*movieResolver, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, q.fail(ctx, err)
+	}
+
+	filters := 0
+	for _, set := range []bool{args.Query != nil, args.Genre != nil, args.Year != nil, args.Director != nil} {
+		if set {
+			filters++
+		}
+	}
+	if filters > 1 {
+		return nil, q.fail(ctx, shared.NewValidationError("only one of query, genre, year and director may be set", nil))
+	}
+
+	var found *shared.Page[*schema.Movie]
+	switch {
+	case args.Query != nil:
+		found, err = q.services.Movies.SearchMovies(ctx, *args.Query, page)
+	case args.Genre != nil:
+		found, err = q.services.Movies.GetMoviesByGenre(ctx, *args.Genre, page)
+	case args.Year != nil:
+		if *args.Year < 1800 || *args.Year > 9999 {
+			return nil, q.fail(ctx, shared.NewFieldError("year", "must be a four digit year"))
+		}
+		found, err = q.services.Movies.GetMoviesByYear(ctx, int(*args.Year), page)
+	case args.Director != nil:
+		found, err = q.services.Movies.GetMoviesByDirector(ctx, *args.Director, page)
+	default:
+		found, err = q.services.Movies.GetRecentMovies(ctx, page)
+	}
+	if err != nil {
+		return nil, q.fail(ctx, err)
+	}
+
+	resolvers := make([]*movieResolver, 0, len(found.Items))
+	for _, movie := range found.Items {
+		resolvers = append(resolvers, &movieResolver{q: q, movie: movie})
+	}
+	return resolvers, nil
+}
+
+func (q *queryResolver) TopRatedMovies(ctx context.Context, args struct{ Limit *int32 }) ([]*ratingSummaryResolver, error) {
+	limit, _, err := pageArgs{Limit: args.Limit}.bounds()
+	if err != nil {
+		return nil, q.fail(ctx, err)
+	}
+
+	summaries, err := q.services.Ratings.GetTopRatedMovies(ctx, limit)
+	if err != nil {
+		return nil, q.fail(ctx, err)
+	}
+
+	resolvers := make([]*ratingSummaryResolver, 0, len(summaries))
+	for _, summary := range summaries {
+		resolvers = append(resolvers, &ratingSummaryResolver{q: q, summary: summary})
+	}
+	return resolvers, nil
+}
+
+// loadMovie resolves a movie reference through the request's loader. A movie
+// that no longer exists resolves to null.
+func (q *queryResolver) loadMovie(ctx context.Context, movieID string) (*movieResolver, error) {
+	movie, err := loadersFrom(ctx).movies.Load(ctx, movieID)()
+	if errors.Is(err, shared.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, q.fail(ctx, err)
+	}
+
+	return &movieResolver{q: q, movie: movie}, nil
+}
+
+func (q *queryResolver) loadUser(ctx context.Context, userID string) (*userResolver, error) {
+	u, err := loadersFrom(ctx).users.Load(ctx, userID)()
+	if errors.Is(err, shared.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, q.fail(ctx, err)
+	}
+
+	return &userResolver{q: q, user: u}, nil
+}
+
+func (q *queryResolver) loadRating(ctx context.Context, userID, movieID string) (*ratingResolver, error) {
+	r, err := loadersFrom(ctx).ratings.Load(ctx, ratingKey{userID: userID, movieID: movieID})()
+	if err != nil {
+		return nil, q.fail(ctx, err)
+	}
+	if r == nil {
+		return nil, nil
+	}
+
+	return &ratingResolver{q: q, rating: r}, nil
+}
+
+// fail turns a service error into a GraphQL error. Domain errors are passed
+// on to the client; anything else is logged and hidden, as in the REST API.
+func (q *queryResolver) fail(ctx context.Context, err error) error {
+	var domainErr *shared.DomainError
+	if errors.As(err, &domainErr) {
+		return &queryError{message: domainErr.Message, code: shared.ErrorCode(err), fields: domainErr.Fields}
+	}
+
+	httpStatus := shared.HTTPStatus(err)
+	if httpStatus == http.StatusInternalServerError {
+		q.logger.ErrorContext(ctx, "query failed", slog.Any("error", err))
+		return &queryError{message: "internal server error", code: shared.ErrorCode(err)}
+	}
+
+	q.logger.WarnContext(ctx, "query failed", slog.Any("error", err))
+	return &queryError{message: strings.ToLower(http.StatusText(httpStatus)), code: shared.ErrorCode(err)}
+}
+
+// queryError is reported with the same code and fields as a REST error body,
+// under the extensions of the GraphQL error.
+type queryError struct {
+	message string
+	code    string
+	fields  map[string]string
+}
+
+func (e *queryError) Error() string {
+	return e.message
+}
+
+func (e *queryError) Extensions() map[string]interface{} {
+	extensions := map[string]interface{}{"code": e.code}
+	if len(e.fields) > 0 {
+		extensions["fields"] = e.fields
+	}
+	return extensions
+}
+
+type userResolver struct {
+	q    *queryResolver
+	user *user.User
+}
+
+func (r *userResolver) ID() graphql.ID          { return graphql.ID(r.user.ID) }
+func (r *userResolver) Username() string        { return r.user.Username }
+func (r *userResolver) CreatedAt() graphql.Time { return graphql.Time{Time: r.user.CreatedAt} }
+func (r *userResolver) UpdatedAt() graphql.Time { return graphql.Time{Time: r.user.UpdatedAt} }
+
+// Email is null unless the caller is the user or an admin, as users are also
+// reached through the ratings and lists of others.
+func (r *userResolver) Email(ctx context.Context) *string {
+	if !shared.Permits(ctx, shared.ActionReadUser, r.user.ID) {
+		return nil
+	}
+	return &r.user.Email
+}
+
+func (r *userResolver) Watchlist(ctx context.Context) ([]*watchlistEntryResolver, error) {
+	entries, err := r.q.services.Watchlist.GetWatchlist(ctx, r.user.ID)
+	if err != nil {
+		return nil, r.q.fail(ctx, err)
+	}
+
+	resolvers := make([]*watchlistEntryResolver, 0, len(entries))
+	for _, entry := range entries {
+		resolvers = append(resolvers, &watchlistEntryResolver{q: r.q, entry: entry})
+	}
+	return resolvers, nil
+}
+
+func (r *userResolver) WatchHistory(ctx context.Context, args pageArgs) ([]*watchHistoryResolver, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, r.q.fail(ctx, err)
+	}
+
+	history, err := r.q.services.Library.GetWatchHistory(ctx, r.user.ID, page)
+	if err != nil {
+		return nil, r.q.fail(ctx, err)
+	}
+
+	resolvers := make([]*watchHistoryResolver, 0, len(history.Items))
+	for _, entry := range history.Items {
+		resolvers = append(resolvers, &watchHistoryResolver{q: r.q, history: entry})
+	}
+	return resolvers, nil
+}
+
+func (r *userResolver) Ratings(ctx context.Context, args pageArgs) ([]*ratingResolver, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, r.q.fail(ctx, err)
+	}
+
+	ratings, err := r.q.services.Ratings.GetUserRatings(ctx, r.user.ID, page)
+	if err != nil {
+		return nil, r.q.fail(ctx, err)
+	}
+
+	return r.q.ratingResolvers(ratings.Items), nil
+}
+
+func (r *userResolver) Stats(ctx context.Context) (*watchingStatsResolver, error) {
+	stats, err := r.q.services.Library.GetWatchingStats(ctx, r.user.ID)
+	if err != nil {
+		return nil, r.q.fail(ctx, err)
+	}
+
+	return &watchingStatsResolver{stats: stats}, nil
+}
+
+type movieResolver struct {
+	q     *queryResolver
+	movie *schema.Movie
+}
+
+func (r *movieResolver) ID() graphql.ID           { return graphql.ID(r.movie.ID.Hex()) }
+func (r *movieResolver) Title() string            { return r.movie.Title }
+func (r *movieResolver) OriginalTitle() string    { return r.movie.OriginalTitle }
+func (r *movieResolver) OriginalLanguage() string { return r.movie.OriginalLanguage }
+func (r *movieResolver) Overview() string         { return r.movie.Overview }
+func (r *movieResolver) Tagline() string          { return r.movie.Tagline }
+func (r *movieResolver) Status() string           { return r.movie.Status }
+func (r *movieResolver) ReleaseDate() string      { return r.movie.ReleaseDate }
+func (r *movieResolver) Runtime() int32           { return int32(r.movie.Runtime) }
+func (r *movieResolver) PosterPath() string       { return r.movie.PosterPath }
+func (r *movieResolver) ImdbId() string           { return r.movie.IMDbID }
+func (r *movieResolver) CreatedAt() graphql.Time  { return graphql.Time{Time: r.movie.CreatedAt} }
+func (r *movieResolver) UpdatedAt() graphql.Time  { return graphql.Time{Time: r.movie.UpdatedAt} }
+
+func (r *movieResolver) Genres() []string {
+	genres := make([]string, 0, len(r.movie.Genres))
+	for _, genre := range r.movie.Genres {
+		genres = append(genres, genre.Name)
+	}
+	return genres
+}
+
+func (r *movieResolver) RatingSummary(ctx context.Context) (*ratingSummaryResolver, error) {
+	summary, err := loadersFrom(ctx).summaries.Load(ctx, r.movie.ID.Hex())()
+	if err != nil {
+		return nil, r.q.fail(ctx, err)
+	}
+
+	return &ratingSummaryResolver{q: r.q, summary: summary}, nil
+}
+
+func (r *movieResolver) Ratings(ctx context.Context, args pageArgs) ([]*ratingResolver, error) {
+	page, err := args.page()
+	if err != nil {
+		return nil, r.q.fail(ctx, err)
+	}
+
+	ratings, err := r.q.services.Ratings.GetMovieRatings(ctx, r.movie.ID.Hex(), page)
+	if err != nil {
+		return nil, r.q.fail(ctx, err)
+	}
+
+	return r.q.ratingResolvers(ratings.Items), nil
+}
+
+type watchlistEntryResolver struct {
+	q     *queryResolver
+	entry *watchlist.WatchlistEntry
+}
+
+func (r *watchlistEntryResolver) ID() graphql.ID        { return graphql.ID(r.entry.ID) }
+func (r *watchlistEntryResolver) Notes() string         { return r.entry.Notes }
+func (r *watchlistEntryResolver) AddedAt() graphql.Time { return graphql.Time{Time: r.entry.AddedAt} }
+
+func (r *watchlistEntryResolver) User(ctx context.Context) (*userResolver, error) {
+	return r.q.loadUser(ctx, r.entry.UserID)
+}
+
+func (r *watchlistEntryResolver) Movie(ctx context.Context) (*movieResolver, error) {
+	return r.q.loadMovie(ctx, r.entry.MovieID)
+}
+
+func (r *watchlistEntryResolver) Rating(ctx context.Context) (*ratingResolver, error) {
+	return r.q.loadRating(ctx, r.entry.UserID, r.entry.MovieID)
+}
+
+type watchHistoryResolver struct {
+	q       *queryResolver
+	history *library.WatchHistory
+}
+
+func (r *watchHistoryResolver) ID() graphql.ID { return graphql.ID(r.history.ID) }
+func (r *watchHistoryResolver) WatchedAt() graphql.Time {
+	return graphql.Time{Time: r.history.WatchedAt}
+}
+func (r *watchHistoryResolver) DurationWatched() int32 { return int32(r.history.Duration) }
+
+func (r *watchHistoryResolver) User(ctx context.Context) (*userResolver, error) {
+	return r.q.loadUser(ctx, r.history.UserID)
+}
+
+func (r *watchHistoryResolver) Movie(ctx context.Context) (*movieResolver, error) {
+	return r.q.loadMovie(ctx, r.history.MovieID)
+}
+
+func (r *watchHistoryResolver) Rating(ctx context.Context) (*ratingResolver, error) {
+	return r.q.loadRating(ctx, r.history.UserID, r.history.MovieID)
+}
+
+type ratingResolver struct {
+	q      *queryResolver
+	rating *rating.MovieRating
+}
+
+func (q *queryResolver) ratingResolvers(ratings []*rating.MovieRating) []*ratingResolver {
+	resolvers := make([]*ratingResolver, 0, len(ratings))
+	for _, r := range ratings {
+		resolvers = append(resolvers, &ratingResolver{q: q, rating: r})
+	}
+	return resolvers
+}
+
+func (r *ratingResolver) ID() graphql.ID          { return graphql.ID(r.rating.ID) }
+func (r *ratingResolver) Rating() float64         { return r.rating.Rating }
+func (r *ratingResolver) Review() string          { return r.rating.Review }
+func (r *ratingResolver) CreatedAt() graphql.Time { return graphql.Time{Time: r.rating.CreatedAt} }
+func (r *ratingResolver) UpdatedAt() graphql.Time { return graphql.Time{Time: r.rating.UpdatedAt} }
+
+func (r *ratingResolver) User(ctx context.Context) (*userResolver, error) {
+	return r.q.loadUser(ctx, r.rating.UserID)
+}
+
+func (r *ratingResolver) Movie(ctx context.Context) (*movieResolver, error) {
+	return r.q.loadMovie(ctx, r.rating.MovieID)
+}
+
+type ratingSummaryResolver struct {
+	q       *queryResolver
+	summary *rating.RatingSummary
+}
+
+func (r *ratingSummaryResolver) MovieId() graphql.ID { return graphql.ID(r.summary.MovieID) }
+func (r *ratingSummaryResolver) AvgRating() float64  { return r.summary.AvgRating }
+func (r *ratingSummaryResolver) Count() int32        { return int32(r.summary.Count) }
+
+func (r *ratingSummaryResolver) Movie(ctx context.Context) (*movieResolver, error) {
+	return r.q.loadMovie(ctx, r.summary.MovieID)
+}
+
+type watchingStatsResolver struct {
+	stats *library.WatchingStats
+}
+
+func (r *watchingStatsResolver) TotalMoviesWatched() int32 { return int32(r.stats.TotalMoviesWatched) }
+func (r *watchingStatsResolver) TotalMinutes() int32       { return int32(r.stats.TotalMinutes) }
+func (r *watchingStatsResolver) TotalHours() float64       { return r.stats.TotalHours }
+func (r *watchingStatsResolver) TotalMoviesWatchedThisMonth() int32 {
+	return int32(r.stats.TotalMoviesWatchedThisMonth)
+}
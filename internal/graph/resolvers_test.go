@@ -0,0 +1,82 @@
+package graph
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	"event-driven-go/internal/domains/user"
+	"event-driven-go/internal/shared"
+)
+
+var discardLogger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// userRepository holds the users the loaders find.
+type userRepository struct {
+	user.RepositoryInterface
+	users []*user.User
+}
+
+func (r *userRepository) GetUsersByIDs(_ context.Context, ids []string) ([]*user.User, error) {
+	var found []*user.User
+	for _, u := range r.users {
+		for _, id := range ids {
+			if u.ID == id {
+				found = append(found, u)
+			}
+		}
+	}
+	return found, nil
+}
+
+func TestNestedUserEmail(t *testing.T) {
+	repository := &userRepository{users: []*user.User{{ID: "alice", Username: "alice", Email: "alice@example.com"}}}
+	services := Services{Users: user.NewService(repository, nil, nil, discardLogger)}
+	q := &queryResolver{services: services, logger: discardLogger}
+
+	tests := []struct {
+		name      string
+		principal *shared.Principal
+		visible   bool
+	}{
+		{name: "anonymous"},
+		{name: "another user", principal: &shared.Principal{UserID: "bob", Role: shared.RoleUser}},
+		{name: "curator", principal: &shared.Principal{UserID: "carol", Role: shared.RoleCurator}},
+		{name: "the user", principal: &shared.Principal{UserID: "alice", Role: shared.RoleUser}, visible: true},
+		{name: "admin", principal: &shared.Principal{UserID: "dave", Role: shared.RoleAdmin}, visible: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := contextWithLoaders(context.Background(), newLoaders(services))
+			if tt.principal != nil {
+				ctx = shared.ContextWithPrincipal(ctx, *tt.principal)
+			}
+
+			// How the user of a rating, watchlist entry or history entry is resolved
+			resolved, err := q.loadUser(ctx, "alice")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if resolved.Username() != "alice" {
+				t.Errorf("got username %q, want alice", resolved.Username())
+			}
+			email := resolved.Email(ctx)
+			if (email != nil) != tt.visible {
+				t.Errorf("got email %v, want visible %t", email, tt.visible)
+			}
+		})
+	}
+}
+
+func TestSchemaMatchesResolvers(t *testing.T) {
+	defer func() {
+		if err := recover(); err != nil {
+			t.Fatalf("the schema does not match the resolvers: %v", err)
+		}
+	}()
+
+	NewHTTPHandler(Services{}, discardLogger)
+}
@@ -0,0 +1,328 @@
+package migrations
+
+import (
+	"context"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+//go:embed sql/*.sql
+var files embed.FS
+
+// advisoryLockID identifies the PostgreSQL advisory lock held while migrating,
+// so that concurrently booting instances apply migrations one at a time.
+const advisoryLockID int64 = 7_305_011_827
+
+var fileNamePattern = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+
+// Migration is a numbered pair of up and down files. Checksum covers both, so
+// that editing either after the migration was applied is noticed.
+type Migration struct {
+	Version  int64
+	Name     string
+	Up       string
+	Down     string
+	Checksum string
+
+	// upChecksum is the checksum of the up file alone, which migrations were
+	// recorded with before the down file was included.
+	upChecksum string
+}
+
+type MigrationStatus struct {
+	Version   int64
+	Name      string
+	Applied   bool
+	AppliedAt *time.Time
+}
+
+type schemaMigration struct {
+	Version   int64     `gorm:"primaryKey"`
+	Name      string    `gorm:"not null"`
+	Checksum  string    `gorm:"not null"`
+	AppliedAt time.Time `gorm:"not null"`
+}
+
+func (schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+	logger     *slog.Logger
+}
+
+func NewMigrator(db *gorm.DB, logger *slog.Logger) (*Migrator, error) {
+	migrations, err := load(files)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+		logger:     logger.With(slog.String("component", "migrations")),
+	}, nil
+}
+
+// Up applies every pending migration in version order, each in its own transaction.
+func (m *Migrator) Up(ctx context.Context) error {
+	return m.withLock(ctx, func(conn *gorm.DB) error {
+		applied, err := m.applied(conn)
+		if err != nil {
+			return err
+		}
+		if err := m.verify(conn, applied); err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			if _, ok := applied[migration.Version]; ok {
+				continue
+			}
+
+			err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Exec(migration.Up).Error; err != nil {
+					return err
+				}
+				return tx.Create(&schemaMigration{
+					Version:   migration.Version,
+					Name:      migration.Name,
+					Checksum:  migration.Checksum,
+					AppliedAt: time.Now(),
+				}).Error
+			})
+			if err != nil {
+				return fmt.Errorf("failed to apply migration %04d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			m.logger.InfoContext(ctx, "applied migration",
+				slog.Int64("version", migration.Version),
+				slog.String("name", migration.Name),
+			)
+		}
+
+		return nil
+	})
+}
+
+// Down rolls back the given number of most recently applied migrations.
+func (m *Migrator) Down(ctx context.Context, steps int) error {
+	if steps <= 0 {
+		return fmt.Errorf("steps must be positive, got %d", steps)
+	}
+
+	return m.withLock(ctx, func(conn *gorm.DB) error {
+		applied, err := m.applied(conn)
+		if err != nil {
+			return err
+		}
+		if err := m.verify(conn, applied); err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0 && steps > 0; i-- {
+			migration := m.migrations[i]
+			if _, ok := applied[migration.Version]; !ok {
+				continue
+			}
+
+			err := conn.Transaction(func(tx *gorm.DB) error {
+				if err := tx.Exec(migration.Down).Error; err != nil {
+					return err
+				}
+				return tx.Delete(&schemaMigration{}, "version = ?", migration.Version).Error
+			})
+			if err != nil {
+				return fmt.Errorf("failed to roll back migration %04d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			m.logger.InfoContext(ctx, "rolled back migration",
+				slog.Int64("version", migration.Version),
+				slog.String("name", migration.Name),
+			)
+			steps--
+		}
+
+		return nil
+	})
+}
+
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	var statuses []MigrationStatus
+
+	err := m.withLock(ctx, func(conn *gorm.DB) error {
+		applied, err := m.applied(conn)
+		if err != nil {
+			return err
+		}
+		if err := m.verify(conn, applied); err != nil {
+			return err
+		}
+
+		for _, migration := range m.migrations {
+			status := MigrationStatus{
+				Version: migration.Version,
+				Name:    migration.Name,
+			}
+			if record, ok := applied[migration.Version]; ok {
+				status.Applied = true
+				status.AppliedAt = &record.AppliedAt
+			}
+			statuses = append(statuses, status)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return statuses, nil
+}
+
+// withLock pins a single connection, since advisory locks are session scoped,
+// and holds the migration lock for the duration of fn.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *gorm.DB) error) error {
+	return m.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		if err := conn.Exec("SELECT pg_advisory_lock(?)", advisoryLockID).Error; err != nil {
+			return fmt.Errorf("failed to acquire migration lock: %w", err)
+		}
+		defer func() {
+			if err := conn.Exec("SELECT pg_advisory_unlock(?)", advisoryLockID).Error; err != nil {
+				m.logger.ErrorContext(ctx, "failed to release migration lock", slog.Any("error", err))
+			}
+		}()
+
+		if err := conn.Exec(`CREATE TABLE IF NOT EXISTS schema_migrations (
+			version    BIGINT PRIMARY KEY,
+			name       TEXT NOT NULL,
+			checksum   TEXT NOT NULL,
+			applied_at TIMESTAMPTZ NOT NULL
+		)`).Error; err != nil {
+			return fmt.Errorf("failed to create schema_migrations table: %w", err)
+		}
+
+		return fn(conn)
+	})
+}
+
+func (m *Migrator) applied(conn *gorm.DB) (map[int64]schemaMigration, error) {
+	var records []schemaMigration
+	if err := conn.Order("version").Find(&records).Error; err != nil {
+		return nil, fmt.Errorf("failed to read applied migrations: %w", err)
+	}
+
+	applied := make(map[int64]schemaMigration, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+
+	return applied, nil
+}
+
+// verify refuses to run when an applied migration was edited or removed
+// after the fact, since the database would no longer match the files.
+// Migrations recorded with the checksum of their up file alone are recorded
+// again with the current one.
+func (m *Migrator) verify(conn *gorm.DB, applied map[int64]schemaMigration) error {
+	known := make(map[int64]Migration, len(m.migrations))
+	for _, migration := range m.migrations {
+		known[migration.Version] = migration
+	}
+
+	for version, record := range applied {
+		migration, ok := known[version]
+		if !ok {
+			return fmt.Errorf("applied migration %04d_%s has no migration file", version, record.Name)
+		}
+		if record.Checksum == migration.upChecksum {
+			err := conn.Model(&schemaMigration{}).Where("version = ?", version).Update("checksum", migration.Checksum).Error
+			if err != nil {
+				return fmt.Errorf("failed to update checksum of migration %04d_%s: %w", version, record.Name, err)
+			}
+			continue
+		}
+		if migration.Checksum != record.Checksum {
+			return fmt.Errorf("checksum mismatch for applied migration %04d_%s", version, record.Name)
+		}
+	}
+
+	return nil
+}
+
+func load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, "sql")
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migrations: %w", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		matches := fileNamePattern.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			return nil, fmt.Errorf("invalid migration file name: %s", entry.Name())
+		}
+
+		version, err := strconv.ParseInt(matches[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version in %s: %w", entry.Name(), err)
+		}
+
+		content, err := fs.ReadFile(fsys, path.Join("sql", entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read migration %s: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = migration
+		} else if migration.Name != matches[2] {
+			return nil, fmt.Errorf("migration version %04d is used by %s and %s", version, migration.Name, matches[2])
+		}
+
+		if matches[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %04d_%s must have both up and down files", migration.Version, migration.Name)
+		}
+		migration.Checksum = checksum(migration.Up, migration.Down)
+		migration.upChecksum = checksum(migration.Up)
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// checksum hashes the contents with their lengths, so that moving text from
+// one file to the other changes it too.
+func checksum(contents ...string) string {
+	hash := sha256.New()
+	for _, content := range contents {
+		fmt.Fprintf(hash, "%d:%s", len(content), content)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
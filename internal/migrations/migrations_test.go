@@ -0,0 +1,44 @@
+package migrations
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestLoadChecksumCoversBothFiles(t *testing.T) {
+	migrationFiles := func(up, down string) fstest.MapFS {
+		return fstest.MapFS{
+			"sql/0001_create_users.up.sql":   {Data: []byte(up)},
+			"sql/0001_create_users.down.sql": {Data: []byte(down)},
+		}
+	}
+	checksumOf := func(files fstest.MapFS) string {
+		t.Helper()
+		migrations, err := load(files)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return migrations[0].Checksum
+	}
+
+	original := checksumOf(migrationFiles("CREATE TABLE users ();", "DROP TABLE users;"))
+
+	tests := map[string]fstest.MapFS{
+		"edited up":   migrationFiles("CREATE TABLE users (id TEXT);", "DROP TABLE users;"),
+		"edited down": migrationFiles("CREATE TABLE users ();", "DROP TABLE IF EXISTS users;"),
+		"moved text":  migrationFiles("CREATE TABLE users ();DROP", " TABLE users;"),
+	}
+	for name, files := range tests {
+		if checksumOf(files) == original {
+			t.Errorf("%s: the checksum did not change", name)
+		}
+	}
+}
+
+func TestLoadRequiresBothFiles(t *testing.T) {
+	files := fstest.MapFS{"sql/0001_create_users.up.sql": {Data: []byte("CREATE TABLE users ();")}}
+
+	if _, err := load(files); err == nil {
+		t.Error("a migration without a down file was loaded")
+	}
+}
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS watchlist_entries (
+    id         VARCHAR(36) PRIMARY KEY,
+    user_id    VARCHAR(36) NOT NULL,
+    movie_id   VARCHAR(24) NOT NULL,
+    added_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    notes      TEXT,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_watchlist_entries_user_id ON watchlist_entries (user_id);
+CREATE INDEX IF NOT EXISTS idx_watchlist_entries_movie_id ON watchlist_entries (movie_id);
+CREATE INDEX IF NOT EXISTS idx_watchlist_entries_deleted_at ON watchlist_entries (deleted_at);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS watch_history (
+    id         VARCHAR(36) PRIMARY KEY,
+    user_id    VARCHAR(36) NOT NULL,
+    movie_id   VARCHAR(24) NOT NULL,
+    watched_at TIMESTAMPTZ NOT NULL,
+    duration   BIGINT DEFAULT 0,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_watch_history_user_id ON watch_history (user_id);
+CREATE INDEX IF NOT EXISTS idx_watch_history_movie_id ON watch_history (movie_id);
+CREATE INDEX IF NOT EXISTS idx_watch_history_deleted_at ON watch_history (deleted_at);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS movie_ratings (
+    id         VARCHAR(36) PRIMARY KEY,
+    user_id    VARCHAR(36) NOT NULL,
+    movie_id   VARCHAR(24) NOT NULL,
+    rating     DECIMAL(3, 2) NOT NULL,
+    review     TEXT,
+    created_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT chk_movie_ratings_rating CHECK (rating >= 0 AND rating <= 5)
+);
+
+CREATE INDEX IF NOT EXISTS idx_movie_ratings_user_id ON movie_ratings (user_id);
+CREATE INDEX IF NOT EXISTS idx_movie_ratings_movie_id ON movie_ratings (movie_id);
+CREATE INDEX IF NOT EXISTS idx_movie_ratings_deleted_at ON movie_ratings (deleted_at);
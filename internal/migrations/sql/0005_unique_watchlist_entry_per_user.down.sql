@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_watchlist_entries_user_movie;
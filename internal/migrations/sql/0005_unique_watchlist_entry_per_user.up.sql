@@ -0,0 +1,17 @@
+-- Databases created by AutoMigrate may already hold duplicates; keep the most
+-- recently added entry and soft delete the rest before enforcing uniqueness.
+UPDATE watchlist_entries
+SET deleted_at = now()
+WHERE id IN (
+    SELECT id
+    FROM (
+        SELECT id, row_number() OVER (PARTITION BY user_id, movie_id ORDER BY added_at DESC) AS position
+        FROM watchlist_entries
+        WHERE deleted_at IS NULL
+    ) ranked
+    WHERE ranked.position > 1
+);
+
+CREATE UNIQUE INDEX idx_watchlist_entries_user_movie
+    ON watchlist_entries (user_id, movie_id)
+    WHERE deleted_at IS NULL;
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_movie_ratings_user_movie;
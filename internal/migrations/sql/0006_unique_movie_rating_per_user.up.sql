@@ -0,0 +1,16 @@
+-- Keep the latest rating per user and movie before enforcing uniqueness.
+UPDATE movie_ratings
+SET deleted_at = now()
+WHERE id IN (
+    SELECT id
+    FROM (
+        SELECT id, row_number() OVER (PARTITION BY user_id, movie_id ORDER BY updated_at DESC NULLS LAST) AS position
+        FROM movie_ratings
+        WHERE deleted_at IS NULL
+    ) ranked
+    WHERE ranked.position > 1
+);
+
+CREATE UNIQUE INDEX idx_movie_ratings_user_movie
+    ON movie_ratings (user_id, movie_id)
+    WHERE deleted_at IS NULL;
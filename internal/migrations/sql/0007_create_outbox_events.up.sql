@@ -0,0 +1,9 @@
+CREATE TABLE outbox_events (
+    id           VARCHAR(36) PRIMARY KEY,
+    event_type   VARCHAR(100) NOT NULL,
+    payload      JSONB NOT NULL,
+    created_at   TIMESTAMPTZ NOT NULL DEFAULT now(),
+    published_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_outbox_events_pending ON outbox_events (created_at) WHERE published_at IS NULL;
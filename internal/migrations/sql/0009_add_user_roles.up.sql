@@ -0,0 +1,2 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS role VARCHAR(20) NOT NULL DEFAULT 'user';
+ALTER TABLE users ADD CONSTRAINT chk_users_role CHECK (role IN ('user', 'curator', 'admin'));
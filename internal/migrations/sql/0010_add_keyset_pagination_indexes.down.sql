@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_movie_ratings_movie_created_at_id;
+DROP INDEX IF EXISTS idx_movie_ratings_user_created_at_id;
+DROP INDEX IF EXISTS idx_watch_history_user_watched_at_id;
+DROP INDEX IF EXISTS idx_users_created_at_id;
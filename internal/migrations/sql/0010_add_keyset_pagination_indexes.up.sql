@@ -0,0 +1,14 @@
+-- Lists are paged by (sort column, id) row comparisons, newest first; these
+-- indexes serve both the filter and the order.
+CREATE INDEX IF NOT EXISTS idx_users_created_at_id
+    ON users (created_at DESC, id DESC)
+    WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_watch_history_user_watched_at_id
+    ON watch_history (user_id, watched_at DESC, id DESC)
+    WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_movie_ratings_user_created_at_id
+    ON movie_ratings (user_id, created_at DESC, id DESC)
+    WHERE deleted_at IS NULL;
+CREATE INDEX IF NOT EXISTS idx_movie_ratings_movie_created_at_id
+    ON movie_ratings (movie_id, created_at DESC, id DESC)
+    WHERE deleted_at IS NULL;
@@ -0,0 +1,101 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// Authenticator resolves an access token to the user it was issued to. Tokens
+// it rejects yield an error matching ErrUnauthenticated.
+type Authenticator interface {
+	Authenticate(ctx context.Context, accessToken string) (Principal, error)
+}
+
+// Principal is the authenticated caller of a request.
+type Principal struct {
+	UserID string
+	Role   Role
+}
+
+// SystemPrincipal is the caller of a maintenance command run by an operator,
+// who acts as an admin and appears in the audit log under the command's name.
+func SystemPrincipal(command string) Principal {
+	return Principal{UserID: "system:" + command, Role: RoleAdmin}
+}
+
+var errAuthenticationRequired = NewUnauthenticatedError("authentication required")
+
+type principalKey struct{}
+
+func ContextWithPrincipal(ctx context.Context, principal Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+// PrincipalFromContext returns the authenticated caller, if any.
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(Principal)
+	return principal, ok
+}
+
+// UserIDFromContext returns the ID of the authenticated user, if any.
+func UserIDFromContext(ctx context.Context) (string, bool) {
+	principal, ok := PrincipalFromContext(ctx)
+	return principal.UserID, ok
+}
+
+// AuthenticatedUserID is UserIDFromContext for calls that require a user,
+// failing with an unauthenticated error when there is none.
+func AuthenticatedUserID(ctx context.Context) (string, error) {
+	userID, ok := UserIDFromContext(ctx)
+	if !ok {
+		return "", errAuthenticationRequired
+	}
+	return userID, nil
+}
+
+// authenticate verifies a bearer token sent with the request and stores its
+// user ID in the request context. Requests without one only pass when the
+// route does not require authentication.
+func authenticate(authenticator Authenticator, required bool, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		if header == "" {
+			if required {
+				writeUnauthenticated(w, r, errAuthenticationRequired)
+				return
+			}
+			next(w, r)
+			return
+		}
+
+		token, ok := bearerToken(header)
+		if !ok {
+			writeUnauthenticated(w, r, NewUnauthenticatedError("authorization header must be a bearer token"))
+			return
+		}
+		principal, err := authenticator.Authenticate(r.Context(), token)
+		if err != nil {
+			writeUnauthenticated(w, r, err)
+			return
+		}
+
+		next(w, r.WithContext(ContextWithPrincipal(r.Context(), principal)))
+	}
+}
+
+func bearerToken(header string) (string, bool) {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
+func writeUnauthenticated(w http.ResponseWriter, r *http.Request, err error) {
+	if errors.Is(err, ErrUnauthenticated) {
+		w.Header().Set("WWW-Authenticate", `Bearer realm="api"`)
+	}
+	WriteServiceError(w, r, Logger, err)
+}
@@ -1,21 +1,33 @@
 package shared
 
 import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
 	"github.com/IBM/sarama"
 	"github.com/joho/godotenv"
 	"log"
+	"log/slog"
 	"os"
 	"strconv"
 	"strings"
+	"time"
 )
 
 var Config *config
 
 type config struct {
-	Postgres PostgreSQLConfig
-	MongoDB  MongoDBConfig
-	Kafka    KafkaConfig
-	App      AppConfig
+	Postgres     PostgreSQLConfig
+	MongoDB      MongoDBConfig
+	ConnectRetry ConnectRetryConfig
+	Kafka        KafkaConfig
+	HTTP         HTTPConfig
+	GRPC         GRPCConfig
+	Auth         AuthConfig
+	Embeddings   EmbeddingsConfig
+	Search       SearchConfig
+	App          AppConfig
 }
 
 type DatabaseConfig struct {
@@ -24,17 +36,33 @@ type DatabaseConfig struct {
 }
 
 type PostgreSQLConfig struct {
-	Host     string
-	Port     int
-	User     string
-	Password string
-	Database string
-	SSLMode  string
+	Host            string
+	Port            int
+	User            string
+	Password        string
+	Database        string
+	SSLMode         string
+	MaxOpenConns    int
+	MaxIdleConns    int
+	ConnMaxLifetime time.Duration
+	ConnMaxIdleTime time.Duration
 }
 
 type MongoDBConfig struct {
-	URI      string
-	Database string
+	URI                    string
+	Database               string
+	MaxPoolSize            int
+	MinPoolSize            int
+	ConnectTimeout         time.Duration
+	ServerSelectionTimeout time.Duration
+}
+
+// ConnectRetryConfig controls how long startup keeps retrying databases that
+// are not reachable yet, e.g. while docker-compose is still starting them.
+type ConnectRetryConfig struct {
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	Timeout        time.Duration
 }
 
 type KafkaConfig struct {
@@ -43,6 +71,52 @@ type KafkaConfig struct {
 	Enabled          bool
 }
 
+type HTTPConfig struct {
+	Port               int
+	ReadTimeout        time.Duration
+	WriteTimeout       time.Duration
+	ShutdownTimeout    time.Duration
+	HealthCheckTimeout time.Duration
+}
+
+// GRPCConfig configures the gRPC server. DefaultTimeout bounds unary calls
+// whose client did not set a deadline.
+type GRPCConfig struct {
+	Port           int
+	DefaultTimeout time.Duration
+}
+
+// AuthConfig configures token issuing. JWTSecret signs access tokens with
+// HS256 and must be at least 32 bytes long.
+type AuthConfig struct {
+	JWTSecret       string
+	Issuer          string
+	AccessTokenTTL  time.Duration
+	RefreshTokenTTL time.Duration
+	BcryptCost      int
+}
+
+// EmbeddingsConfig selects how movie embeddings are computed: "local" hashes
+// words on the machine, "remote" calls an OpenAI compatible embeddings API.
+type EmbeddingsConfig struct {
+	Provider string
+	URL      string
+	APIKey   string
+	Model    string
+	Timeout  time.Duration
+}
+
+// SearchConfig locates the full text search index on disk. An empty
+// IndexPath keeps it in memory, to be rebuilt at every start. Every instance
+// keeps its own index, so the events updating it are consumed in
+// ConsumerGroup, which has to differ between instances and should stay the
+// same across restarts of one. It defaults to the Kafka consumer group
+// followed by -search- and the host name.
+type SearchConfig struct {
+	IndexPath     string
+	ConsumerGroup string
+}
+
 type AppConfig struct {
 	Environment string
 	LogLevel    string
@@ -50,6 +124,7 @@ type AppConfig struct {
 
 func init() {
 	Config = newConfig()
+	Logger = NewLogger(Config.App)
 }
 
 func newConfig() *config {
@@ -63,36 +138,153 @@ func newConfig() *config {
 
 func load() (*config, error) {
 	if err := godotenv.Load(); err != nil {
-		log.Println("Warning: Error loading .env file, using environment variables and defaults")
+		slog.Warn("error loading .env file, using environment variables and defaults")
 	}
 
 	config := &config{
 		Postgres: PostgreSQLConfig{
-			Host:     getEnv("POSTGRES_HOST", "localhost"),
-			Port:     getEnvAsInt("POSTGRES_PORT", 5432),
-			User:     getEnv("POSTGRES_USER", "movieapp"),
-			Password: getEnv("POSTGRES_PASSWORD", "movieapp123"),
-			Database: getEnv("POSTGRES_DB", "movieapp"),
-			SSLMode:  getEnv("POSTGRES_SSLMODE", "disable"),
+			Host:            getEnv("POSTGRES_HOST", "localhost"),
+			Port:            getEnvAsInt("POSTGRES_PORT", 5432),
+			User:            getEnv("POSTGRES_USER", "movieapp"),
+			Password:        getEnv("POSTGRES_PASSWORD", "movieapp123"),
+			Database:        getEnv("POSTGRES_DB", "movieapp"),
+			SSLMode:         getEnv("POSTGRES_SSLMODE", "disable"),
+			MaxOpenConns:    getEnvAsInt("POSTGRES_MAX_OPEN_CONNS", 25),
+			MaxIdleConns:    getEnvAsInt("POSTGRES_MAX_IDLE_CONNS", 5),
+			ConnMaxLifetime: getEnvAsDuration("POSTGRES_CONN_MAX_LIFETIME", 30*time.Minute),
+			ConnMaxIdleTime: getEnvAsDuration("POSTGRES_CONN_MAX_IDLE_TIME", 5*time.Minute),
 		},
 		MongoDB: MongoDBConfig{
-			URI:      getEnv("MONGODB_URI", "mongodb://localhost:27017"),
-			Database: getEnv("MONGODB_DATABASE", "movieapp"),
+			URI:                    getEnv("MONGODB_URI", "mongodb://localhost:27017"),
+			Database:               getEnv("MONGODB_DATABASE", "movieapp"),
+			MaxPoolSize:            getEnvAsInt("MONGODB_MAX_POOL_SIZE", 100),
+			MinPoolSize:            getEnvAsInt("MONGODB_MIN_POOL_SIZE", 0),
+			ConnectTimeout:         getEnvAsDuration("MONGODB_CONNECT_TIMEOUT", 10*time.Second),
+			ServerSelectionTimeout: getEnvAsDuration("MONGODB_SERVER_SELECTION_TIMEOUT", 5*time.Second),
+		},
+		ConnectRetry: ConnectRetryConfig{
+			InitialBackoff: getEnvAsDuration("DB_CONNECT_RETRY_INITIAL_BACKOFF", 500*time.Millisecond),
+			MaxBackoff:     getEnvAsDuration("DB_CONNECT_RETRY_MAX_BACKOFF", 10*time.Second),
+			Timeout:        getEnvAsDuration("DB_CONNECT_TIMEOUT", time.Minute),
 		},
 		Kafka: KafkaConfig{
 			BootstrapServers: getEnv("KAFKA_BOOTSTRAP_SERVERS", "localhost:9092"),
 			ConsumerGroup:    getEnv("KAFKA_CONSUMER_GROUP", "movieapp"),
 			Enabled:          getEnvAsBool("KAFKA_ENABLED", true),
 		},
+		HTTP: HTTPConfig{
+			Port:               getEnvAsInt("HTTP_PORT", 8080),
+			ReadTimeout:        getEnvAsDuration("HTTP_READ_TIMEOUT", 10*time.Second),
+			WriteTimeout:       getEnvAsDuration("HTTP_WRITE_TIMEOUT", 30*time.Second),
+			ShutdownTimeout:    getEnvAsDuration("HTTP_SHUTDOWN_TIMEOUT", 15*time.Second),
+			HealthCheckTimeout: getEnvAsDuration("HEALTH_CHECK_TIMEOUT", 2*time.Second),
+		},
+		GRPC: GRPCConfig{
+			Port:           getEnvAsInt("GRPC_PORT", 9090),
+			DefaultTimeout: getEnvAsDuration("GRPC_DEFAULT_TIMEOUT", 10*time.Second),
+		},
+		Auth: AuthConfig{
+			JWTSecret:       getEnv("AUTH_JWT_SECRET", ""),
+			Issuer:          getEnv("AUTH_ISSUER", "movieapp"),
+			AccessTokenTTL:  getEnvAsDuration("AUTH_ACCESS_TOKEN_TTL", 15*time.Minute),
+			RefreshTokenTTL: getEnvAsDuration("AUTH_REFRESH_TOKEN_TTL", 30*24*time.Hour),
+			BcryptCost:      getEnvAsInt("AUTH_BCRYPT_COST", 12),
+		},
+		Embeddings: EmbeddingsConfig{
+			Provider: getEnv("EMBEDDINGS_PROVIDER", "local"),
+			URL:      getEnv("EMBEDDINGS_URL", "https://api.openai.com/v1/embeddings"),
+			APIKey:   getEnv("EMBEDDINGS_API_KEY", ""),
+			Model:    getEnv("EMBEDDINGS_MODEL", "text-embedding-3-small"),
+			Timeout:  getEnvAsDuration("EMBEDDINGS_TIMEOUT", 10*time.Second),
+		},
+		Search: SearchConfig{
+			IndexPath:     getEnv("SEARCH_INDEX_PATH", "data/movies.bleve"),
+			ConsumerGroup: getEnv("SEARCH_CONSUMER_GROUP", ""),
+		},
 		App: AppConfig{
 			Environment: getEnv("APP_ENV", "development"),
 			LogLevel:    getEnv("LOG_LEVEL", "info"),
 		},
 	}
 
+	if err := config.Auth.ensureSecret(config.App.Environment); err != nil {
+		return nil, err
+	}
+	if err := config.MongoDB.validate(); err != nil {
+		return nil, err
+	}
+	if err := config.ConnectRetry.validate(); err != nil {
+		return nil, err
+	}
+	if config.Search.ConsumerGroup == "" {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return nil, fmt.Errorf("failed to name the search consumer group: %w", err)
+		}
+		config.Search.ConsumerGroup = config.Kafka.ConsumerGroup + "-search-" + hostname
+	}
+
 	return config, nil
 }
 
+// validate rejects pool sizes that would wrap around when handed to the
+// driver as unsigned numbers, and timeouts that fail every connection at once.
+func (c *MongoDBConfig) validate() error {
+	if c.MaxPoolSize < 0 {
+		return errors.New("MONGODB_MAX_POOL_SIZE must not be negative")
+	}
+	if c.MinPoolSize < 0 {
+		return errors.New("MONGODB_MIN_POOL_SIZE must not be negative")
+	}
+	// A maximum of zero leaves the pool unbounded
+	if c.MaxPoolSize > 0 && c.MinPoolSize > c.MaxPoolSize {
+		return errors.New("MONGODB_MIN_POOL_SIZE must not be above MONGODB_MAX_POOL_SIZE")
+	}
+	if c.ConnectTimeout <= 0 {
+		return errors.New("MONGODB_CONNECT_TIMEOUT must be positive")
+	}
+	if c.ServerSelectionTimeout <= 0 {
+		return errors.New("MONGODB_SERVER_SELECTION_TIMEOUT must be positive")
+	}
+	return nil
+}
+
+// validate rejects backoffs that would retry the databases in a tight loop
+// and timeouts that give up before the first attempt.
+func (c *ConnectRetryConfig) validate() error {
+	if c.InitialBackoff <= 0 {
+		return errors.New("DB_CONNECT_RETRY_INITIAL_BACKOFF must be positive")
+	}
+	if c.MaxBackoff < c.InitialBackoff {
+		return errors.New("DB_CONNECT_RETRY_MAX_BACKOFF must not be below DB_CONNECT_RETRY_INITIAL_BACKOFF")
+	}
+	if c.Timeout <= 0 {
+		return errors.New("DB_CONNECT_TIMEOUT must be positive")
+	}
+	return nil
+}
+
+const minJWTSecretLength = 32
+
+// ensureSecret requires a configured secret outside development. In
+// development a random one is generated, so tokens do not survive a restart.
+func (c *AuthConfig) ensureSecret(environment string) error {
+	if c.JWTSecret == "" && environment == "development" {
+		secret := make([]byte, minJWTSecretLength)
+		if _, err := rand.Read(secret); err != nil {
+			return fmt.Errorf("failed to generate JWT secret: %w", err)
+		}
+		c.JWTSecret = hex.EncodeToString(secret)
+		slog.Warn("AUTH_JWT_SECRET is not set, using a random secret")
+		return nil
+	}
+
+	if len(c.JWTSecret) < minJWTSecretLength {
+		return fmt.Errorf("AUTH_JWT_SECRET must be at least %d bytes long", minJWTSecretLength)
+	}
+	return nil
+}
+
 func getEnv(key, defaultValue string) string {
 	if value, exists := os.LookupEnv(key); exists {
 		return value
@@ -116,6 +308,14 @@ func getEnvAsBool(key string, defaultValue bool) bool {
 	return defaultValue
 }
 
+func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
+	valueStr := getEnv(key, "")
+	if value, err := time.ParseDuration(valueStr); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
 func getEnvAsSlice(key string, defaultValue []string, sep string) []string {
 	valueStr := getEnv(key, "")
 	if valueStr == "" {
@@ -134,6 +334,7 @@ func (c *config) GetSaramaConfig() *sarama.Config {
 	config.Producer.RequiredAcks = sarama.WaitForAll
 	config.Producer.Retry.Max = 5
 	config.Consumer.Return.Errors = true
+	config.Consumer.Offsets.Initial = sarama.OffsetOldest
 
 	return config
 }
@@ -0,0 +1,63 @@
+package shared
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMongoDBConfigValidate(t *testing.T) {
+	valid := MongoDBConfig{MaxPoolSize: 100, ConnectTimeout: 10 * time.Second, ServerSelectionTimeout: 5 * time.Second}
+
+	tests := []struct {
+		name   string
+		config func(*MongoDBConfig)
+		valid  bool
+	}{
+		{name: "defaults", config: func(*MongoDBConfig) {}, valid: true},
+		{name: "unbounded pool", config: func(c *MongoDBConfig) { c.MaxPoolSize = 0; c.MinPoolSize = 10 }, valid: true},
+		{name: "minimum up to the maximum", config: func(c *MongoDBConfig) { c.MinPoolSize = 100 }, valid: true},
+		{name: "negative maximum pool size", config: func(c *MongoDBConfig) { c.MaxPoolSize = -1 }},
+		{name: "negative minimum pool size", config: func(c *MongoDBConfig) { c.MinPoolSize = -1 }},
+		{name: "minimum above the maximum", config: func(c *MongoDBConfig) { c.MinPoolSize = 101 }},
+		{name: "zero connect timeout", config: func(c *MongoDBConfig) { c.ConnectTimeout = 0 }},
+		{name: "negative connect timeout", config: func(c *MongoDBConfig) { c.ConnectTimeout = -time.Second }},
+		{name: "zero server selection timeout", config: func(c *MongoDBConfig) { c.ServerSelectionTimeout = 0 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := valid
+			tt.config(&config)
+			if err := config.validate(); (err == nil) != tt.valid {
+				t.Errorf("got %v, want valid %t", err, tt.valid)
+			}
+		})
+	}
+}
+
+func TestConnectRetryConfigValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		initial time.Duration
+		max     time.Duration
+		timeout time.Duration
+		valid   bool
+	}{
+		{name: "defaults", initial: 500 * time.Millisecond, max: 10 * time.Second, timeout: time.Minute, valid: true},
+		{name: "constant backoff", initial: time.Second, max: time.Second, timeout: time.Minute, valid: true},
+		{name: "zero initial backoff", initial: 0, max: 10 * time.Second, timeout: time.Minute},
+		{name: "negative initial backoff", initial: -time.Second, max: 10 * time.Second, timeout: time.Minute},
+		{name: "max below initial", initial: time.Second, max: 0, timeout: time.Minute},
+		{name: "zero timeout", initial: time.Second, max: 10 * time.Second, timeout: 0},
+		{name: "negative timeout", initial: time.Second, max: 10 * time.Second, timeout: -time.Minute},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := ConnectRetryConfig{InitialBackoff: tt.initial, MaxBackoff: tt.max, Timeout: tt.timeout}
+			if err := config.validate(); (err == nil) != tt.valid {
+				t.Errorf("got %v, want valid %t", err, tt.valid)
+			}
+		})
+	}
+}
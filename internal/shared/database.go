@@ -3,23 +3,49 @@ package shared
 import (
 	"context"
 	"fmt"
-	"log"
+	"log/slog"
 	"time"
 
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
-	gormlogger "gorm.io/gorm/logger"
 )
 
 type DatabaseConnections struct {
 	PostgreSQL *gorm.DB
 	MongoDB    *mongo.Database
-	logger     *log.Logger
+	logger     *slog.Logger
 }
 
-func NewDatabaseConnections() (*DatabaseConnections, error) {
+// NewDatabaseConnections opens both databases, retrying with exponential
+// backoff until they answer a ping or Config.ConnectRetry.Timeout elapses.
+func NewDatabaseConnections(ctx context.Context, logger *slog.Logger) (*DatabaseConnections, error) {
+	ctx, cancel := context.WithTimeout(ctx, Config.ConnectRetry.Timeout)
+	defer cancel()
+
+	pgDB, err := connectPostgreSQL(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	mongoDB, err := connectMongoDB(ctx, logger)
+	if err != nil {
+		if sqlDB, err := pgDB.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+		return nil, err
+	}
+
+	return &DatabaseConnections{
+		PostgreSQL: pgDB,
+		MongoDB:    mongoDB,
+		logger:     logger,
+	}, nil
+}
+
+func connectPostgreSQL(ctx context.Context, logger *slog.Logger) (*gorm.DB, error) {
 	pgDSN := fmt.Sprintf("host=%s port=%d user=%s password=%s dbname=%s sslmode=%s",
 		Config.Postgres.Host,
 		Config.Postgres.Port,
@@ -30,14 +56,9 @@ func NewDatabaseConnections() (*DatabaseConnections, error) {
 	)
 
 	gormConfig := &gorm.Config{
-		Logger: gormlogger.New(
-			log.New(log.Writer(), "\r\n", log.LstdFlags),
-			gormlogger.Config{
-				SlowThreshold: time.Second,
-				LogLevel:      gormlogger.Info,
-				Colorful:      true,
-			},
-		),
+		Logger:               NewGormLogger(logger, Config.App.LogLevel),
+		TranslateError:       true,
+		DisableAutomaticPing: true,
 	}
 
 	pgDB, err := gorm.Open(postgres.Open(pgDSN), gormConfig)
@@ -49,27 +70,92 @@ func NewDatabaseConnections() (*DatabaseConnections, error) {
 	if err != nil {
 		return nil, fmt.Errorf("failed to get underlying SQL DB: %w", err)
 	}
-	if err := sqlDB.Ping(); err != nil {
+	sqlDB.SetMaxOpenConns(Config.Postgres.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(Config.Postgres.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(Config.Postgres.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(Config.Postgres.ConnMaxIdleTime)
+
+	if err := retryConnect(ctx, logger, "PostgreSQL", sqlDB.PingContext); err != nil {
+		_ = sqlDB.Close()
 		return nil, fmt.Errorf("failed to ping PostgreSQL: %w", err)
 	}
 
-	log.Println("✅ Connected to PostgreSQL with GORM")
+	logger.Info("connected to PostgreSQL",
+		slog.String("host", Config.Postgres.Host),
+		slog.String("database", Config.Postgres.Database),
+		slog.Int("max_open_conns", Config.Postgres.MaxOpenConns),
+	)
+
+	return pgDB, nil
+}
 
-	mongoClient, err := mongo.Connect(context.Background(), options.Client().ApplyURI(Config.MongoDB.URI))
+func connectMongoDB(ctx context.Context, logger *slog.Logger) (*mongo.Database, error) {
+	clientOptions := options.Client().
+		ApplyURI(Config.MongoDB.URI).
+		SetMaxPoolSize(uint64(Config.MongoDB.MaxPoolSize)).
+		SetMinPoolSize(uint64(Config.MongoDB.MinPoolSize)).
+		SetConnectTimeout(Config.MongoDB.ConnectTimeout).
+		SetServerSelectionTimeout(Config.MongoDB.ServerSelectionTimeout)
+
+	mongoClient, err := mongo.Connect(ctx, clientOptions)
 	if err != nil {
 		return nil, fmt.Errorf("failed to connect to MongoDB: %w", err)
 	}
-	if err := mongoClient.Ping(context.Background(), nil); err != nil {
+
+	ping := func(ctx context.Context) error {
+		return mongoClient.Ping(ctx, nil)
+	}
+	if err := retryConnect(ctx, logger, "MongoDB", ping); err != nil {
+		_ = mongoClient.Disconnect(context.Background())
 		return nil, fmt.Errorf("failed to ping MongoDB: %w", err)
 	}
 
-	mongoDB := mongoClient.Database(Config.MongoDB.Database)
-	log.Println("✅ Connected to MongoDB")
+	logger.Info("connected to MongoDB",
+		slog.String("database", Config.MongoDB.Database),
+		slog.Int("max_pool_size", Config.MongoDB.MaxPoolSize),
+	)
 
-	return &DatabaseConnections{
-		PostgreSQL: pgDB,
-		MongoDB:    mongoDB,
-	}, nil
+	return mongoClient.Database(Config.MongoDB.Database), nil
+}
+
+// retryConnect calls ping until it succeeds, doubling the wait between attempts
+// up to Config.ConnectRetry.MaxBackoff, and gives up when ctx is done.
+func retryConnect(ctx context.Context, logger *slog.Logger, target string, ping func(ctx context.Context) error) error {
+	backoff := Config.ConnectRetry.InitialBackoff
+
+	for attempt := 1; ; attempt++ {
+		err := ping(ctx)
+		if err == nil {
+			return nil
+		}
+
+		logger.Warn("database not reachable yet, retrying",
+			slog.String("database", target),
+			slog.Int("attempt", attempt),
+			slog.Duration("backoff", backoff),
+			slog.Any("error", err),
+		)
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("giving up after %d attempts: %w", attempt, err)
+		case <-time.After(backoff):
+		}
+
+		backoff = min(backoff*2, Config.ConnectRetry.MaxBackoff)
+	}
+}
+
+func (dc *DatabaseConnections) PingPostgreSQL(ctx context.Context) error {
+	sqlDB, err := dc.PostgreSQL.DB()
+	if err != nil {
+		return err
+	}
+	return sqlDB.PingContext(ctx)
+}
+
+func (dc *DatabaseConnections) PingMongoDB(ctx context.Context) error {
+	return dc.MongoDB.Client().Ping(ctx, readpref.Primary())
 }
 
 func (dc *DatabaseConnections) Close() error {
@@ -79,14 +165,14 @@ func (dc *DatabaseConnections) Close() error {
 		if err := sqlDB.Close(); err != nil {
 			errors = append(errors, fmt.Errorf("failed to close PostgreSQL: %w", err))
 		} else {
-			dc.logger.Println("🔌 Closed PostgreSQL connection")
+			dc.logger.Info("closed PostgreSQL connection")
 		}
 	}
 
 	if err := dc.MongoDB.Client().Disconnect(context.Background()); err != nil {
 		errors = append(errors, fmt.Errorf("failed to close MongoDB: %w", err))
 	} else {
-		dc.logger.Println("🔌 Closed MongoDB connection")
+		dc.logger.Info("closed MongoDB connection")
 	}
 
 	if len(errors) > 0 {
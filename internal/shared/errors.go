@@ -0,0 +1,179 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Error kinds shared by all domains. Every DomainError matches exactly one of
+// them with errors.Is, whatever sentinel or wrapping the domain adds on top.
+var (
+	ErrNotFound        = errors.New("not found")
+	ErrAlreadyExists   = errors.New("already exists")
+	ErrValidation      = errors.New("validation failed")
+	ErrConflict        = errors.New("conflict")
+	ErrUnauthenticated = errors.New("unauthenticated")
+	ErrForbidden       = errors.New("forbidden")
+)
+
+// DomainError is an expected failure whose message is safe to show to API
+// clients. Fields carries per-field details of validation errors.
+type DomainError struct {
+	Kind    error
+	Message string
+	Fields  map[string]string
+}
+
+func (e *DomainError) Error() string {
+	return e.Message
+}
+
+func (e *DomainError) Is(target error) bool {
+	return target == e.Kind
+}
+
+// NewNotFoundError reports that the named resource does not exist, e.g. "user not found".
+func NewNotFoundError(resource string) *DomainError {
+	return &DomainError{Kind: ErrNotFound, Message: resource + " not found"}
+}
+
+func NewAlreadyExistsError(message string) *DomainError {
+	return &DomainError{Kind: ErrAlreadyExists, Message: message}
+}
+
+func NewValidationError(message string, fields map[string]string) *DomainError {
+	return &DomainError{Kind: ErrValidation, Message: message, Fields: fields}
+}
+
+// NewFieldError is a validation error about a single field, e.g.
+// NewFieldError("user_id", "must not be empty").
+func NewFieldError(field, problem string) *DomainError {
+	return NewValidationError(field+" "+problem, map[string]string{field: problem})
+}
+
+func NewConflictError(message string) *DomainError {
+	return &DomainError{Kind: ErrConflict, Message: message}
+}
+
+// NewUnauthenticatedError reports missing, invalid or expired credentials.
+func NewUnauthenticatedError(message string) *DomainError {
+	return &DomainError{Kind: ErrUnauthenticated, Message: message}
+}
+
+// NewForbiddenError reports that the authenticated user may not do what they
+// asked for.
+func NewForbiddenError(message string) *DomainError {
+	return &DomainError{Kind: ErrForbidden, Message: message}
+}
+
+// HTTPStatus maps an error to the status code of the response it should produce.
+func HTTPStatus(err error) int {
+	switch {
+	case errors.Is(err, ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, ErrAlreadyExists), errors.Is(err, ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, ErrValidation):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, ErrUnauthenticated):
+		return http.StatusUnauthorized
+	case errors.Is(err, ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, context.DeadlineExceeded):
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// ErrorCode is the machine readable code of an error, as sent in REST error
+// bodies and GraphQL error extensions.
+func ErrorCode(err error) string {
+	return errorCode(HTTPStatus(err))
+}
+
+// GRPCCode maps an error to the gRPC status code it should be reported with.
+func GRPCCode(err error) codes.Code {
+	switch {
+	case err == nil:
+		return codes.OK
+	case errors.Is(err, ErrNotFound):
+		return codes.NotFound
+	case errors.Is(err, ErrAlreadyExists):
+		return codes.AlreadyExists
+	case errors.Is(err, ErrConflict):
+		return codes.Aborted
+	case errors.Is(err, ErrValidation):
+		return codes.InvalidArgument
+	case errors.Is(err, ErrUnauthenticated):
+		return codes.Unauthenticated
+	case errors.Is(err, ErrForbidden):
+		return codes.PermissionDenied
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	default:
+		return codes.Internal
+	}
+}
+
+// GRPCError converts an error into a gRPC status error. Validation fields are
+// attached as a BadRequest detail; internal errors are not disclosed.
+func GRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	code := GRPCCode(err)
+	var domainErr *DomainError
+	if !errors.As(err, &domainErr) {
+		if code == codes.Internal {
+			return status.Error(codes.Internal, "internal server error")
+		}
+		return status.Error(code, err.Error())
+	}
+
+	st := status.New(code, domainErr.Message)
+	if len(domainErr.Fields) == 0 {
+		return st.Err()
+	}
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(domainErr.Fields))
+	for field, problem := range domainErr.Fields {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: field, Description: problem})
+	}
+	detailed, detailErr := st.WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+	if detailErr != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// WriteServiceError answers a request that failed in a service. Domain errors
+// are passed on to the client; anything else is logged and hidden behind a 500.
+func WriteServiceError(w http.ResponseWriter, r *http.Request, logger *slog.Logger, err error) {
+	var domainErr *DomainError
+	if errors.As(err, &domainErr) {
+		WriteError(w, r, HTTPStatus(domainErr), domainErr.Message, domainErr.Fields)
+		return
+	}
+
+	httpStatus := HTTPStatus(err)
+	if httpStatus == http.StatusInternalServerError {
+		logger.ErrorContext(r.Context(), "request failed", slog.Any("error", err))
+		WriteError(w, r, httpStatus, "internal server error", nil)
+		return
+	}
+
+	logger.WarnContext(r.Context(), "request failed", slog.Any("error", err))
+	WriteError(w, r, httpStatus, strings.ToLower(http.StatusText(httpStatus)), nil)
+}
@@ -3,11 +3,14 @@ package shared
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"github.com/IBM/sarama"
-	"log"
+	"log/slog"
 	"os"
 	"os/signal"
+	"reflect"
+	"sync"
 	"syscall"
 	"time"
 
@@ -16,8 +19,13 @@ import (
 
 var GlobalEventBus *EventBus
 
+const (
+	eventIDHeader   = "event_id"
+	eventTypeHeader = "event_type"
+)
+
 func init() {
-	GlobalEventBus = NewEventBus()
+	GlobalEventBus = NewEventBus(Logger)
 }
 
 type Event interface {
@@ -52,8 +60,15 @@ func (e BaseEvent) GetTimestamp() time.Time {
 
 type EventBus struct {
 	eventRegistry map[string]EventRegistration
+	Client        sarama.Client
 	SyncProducer  sarama.SyncProducer
-	Consumer      sarama.Consumer
+	ConsumerGroup sarama.ConsumerGroup
+	admin         sarama.ClusterAdmin
+	group         string
+	logger        *slog.Logger
+
+	mu       sync.RWMutex
+	memberID string
 }
 
 type EventRegistration struct {
@@ -61,7 +76,7 @@ type EventRegistration struct {
 	event        Event
 }
 
-func (eb EventBus) RegisterEventType(eventType string, event Event, handler EventHandler) {
+func (eb *EventBus) RegisterEventType(eventType string, event Event, handler EventHandler) {
 	eb.eventRegistry[eventType] = EventRegistration{
 		handler,
 		event,
@@ -69,13 +84,16 @@ func (eb EventBus) RegisterEventType(eventType string, event Event, handler Even
 }
 
 func (eb *EventBus) Publish(ctx context.Context, event Event) error {
-	log.Printf("Publishing event: %s (ID: %s)", event.GetType(), event.GetID())
+	eb.logger.InfoContext(ctx, "publishing event",
+		slog.String("event_id", event.GetID()),
+		slog.String("event_type", event.GetType()),
+	)
 
 	payloadInBytes, err := json.Marshal(event.GetPayload())
 	if err != nil {
 		return err
 	}
-	err = eb.pushMessageToQueue(event.GetType(), payloadInBytes)
+	err = eb.pushMessageToQueue(event.GetID(), event.GetType(), payloadInBytes)
 	if err != nil {
 		return err
 	}
@@ -91,27 +109,78 @@ func NewBaseEvent(eventType string) BaseEvent {
 	}
 }
 
-func NewEventBus() *EventBus {
-	syncProducer, err := sarama.NewSyncProducer([]string{Config.Kafka.BootstrapServers}, Config.GetSaramaConfig())
+// NewEventBus returns an event bus that accepts registrations but has no
+// broker connection until Connect is called, so that packages can register
+// their event types at init time. It consumes in Config.Kafka.ConsumerGroup,
+// sharing the partitions with the other instances.
+func NewEventBus(logger *slog.Logger) *EventBus {
+	return NewGroupEventBus("", logger)
+}
+
+// NewGroupEventBus returns an event bus consuming in the given group. Read
+// models kept by each instance, rather than in a shared database, need a
+// group of their own per instance to see every event.
+func NewGroupEventBus(group string, logger *slog.Logger) *EventBus {
+	sarama.Logger = slog.NewLogLogger(logger.With(slog.String("component", "sarama")).Handler(), slog.LevelDebug)
+
+	return &EventBus{
+		eventRegistry: make(map[string]EventRegistration),
+		group:         group,
+		logger:        logger.With(slog.String("component", "event_bus")),
+	}
+}
+
+// consumerGroup is the group the bus consumes in, which is only resolved on
+// use as the global bus is created before the configuration is read.
+func (eb *EventBus) consumerGroup() string {
+	if eb.group == "" {
+		return Config.Kafka.ConsumerGroup
+	}
+	return eb.group
+}
+
+// Connect creates the Kafka client, producer, consumer group and cluster admin.
+func (eb *EventBus) Connect() error {
+	client, err := sarama.NewClient([]string{Config.Kafka.BootstrapServers}, Config.GetSaramaConfig())
 	if err != nil {
-		log.Fatal(err)
+		return fmt.Errorf("failed to create Kafka client: %w", err)
 	}
-	consumer, err := sarama.NewConsumer([]string{Config.Kafka.BootstrapServers}, Config.GetSaramaConfig())
+	syncProducer, err := sarama.NewSyncProducerFromClient(client)
 	if err != nil {
-		log.Fatal(err)
+		_ = client.Close()
+		return fmt.Errorf("failed to create Kafka producer: %w", err)
 	}
-
-	return &EventBus{
-		eventRegistry: make(map[string]EventRegistration),
-		SyncProducer:  syncProducer,
-		Consumer:      consumer,
+	consumerGroup, err := sarama.NewConsumerGroupFromClient(eb.consumerGroup(), client)
+	if err != nil {
+		_ = syncProducer.Close()
+		_ = client.Close()
+		return fmt.Errorf("failed to create Kafka consumer group: %w", err)
 	}
+	admin, err := sarama.NewClusterAdminFromClient(client)
+	if err != nil {
+		_ = consumerGroup.Close()
+		_ = syncProducer.Close()
+		_ = client.Close()
+		return fmt.Errorf("failed to create Kafka cluster admin: %w", err)
+	}
+
+	eb.Client = client
+	eb.SyncProducer = syncProducer
+	eb.ConsumerGroup = consumerGroup
+	eb.admin = admin
+	eb.logger.Info("connected to Kafka", slog.String("bootstrap_servers", Config.Kafka.BootstrapServers))
+
+	return nil
 }
 
-func (eb EventBus) pushMessageToQueue(topic string, message []byte) error {
+func (eb *EventBus) pushMessageToQueue(eventID, topic string, message []byte) error {
 	producerMessage := &sarama.ProducerMessage{
 		Topic: topic,
 		Value: sarama.StringEncoder(message),
+		Headers: []sarama.RecordHeader{
+			{Key: []byte(eventIDHeader), Value: []byte(eventID)},
+			{Key: []byte(eventTypeHeader), Value: []byte(topic)},
+		},
 	}
 	_, _, err := eb.SyncProducer.SendMessage(producerMessage)
 	if err != nil {
@@ -121,58 +190,169 @@ func (eb EventBus) pushMessageToQueue(topic string, message []byte) error {
 	return nil
 }
 
-func (eb EventBus) StartConsumers(ctx context.Context) {
-	var consumers []sarama.PartitionConsumer
+// StartConsumers joins the consumer group for every registered event
+// type and dispatches messages to their handlers until a termination signal.
+func (eb *EventBus) StartConsumers(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
 	sigchan := make(chan os.Signal, 1)
 	signal.Notify(sigchan, syscall.SIGINT, syscall.SIGTERM)
 
-	for topic, registration := range eb.eventRegistry {
-		consumer, err := eb.Consumer.ConsumePartition(topic, 0, sarama.OffsetOldest)
-		if err != nil {
-			panic(err)
+	topics := make([]string, 0, len(eb.eventRegistry))
+	for topic := range eb.eventRegistry {
+		topics = append(topics, topic)
+	}
+	eb.logger.Info("consuming messages",
+		slog.String("group", eb.consumerGroup()),
+		slog.Any("topics", topics),
+	)
+
+	go func() {
+		for err := range eb.ConsumerGroup.Errors() {
+			eb.logger.Error("error consuming message", slog.Any("error", err))
 		}
-		consumers = append(consumers, consumer)
-
-		fmt.Printf("Consuming messages for %s\n", topic)
-
-		go func(topic string, handler EventHandler, consumer sarama.PartitionConsumer) {
-			for {
-				select {
-				case err := <-consumer.Errors():
-					fmt.Printf("Error consuming message: %v\n", err)
-				case msg := <-consumer.Messages():
-					if err != nil {
-						fmt.Printf("Error creating event: %v\n", err)
-						continue
-					}
-
-					if err := json.Unmarshal(msg.Value, &registration.event); err != nil {
-						fmt.Printf("Error unmarshaling event: %v\n", err)
-						continue
-					}
-
-					if handler.CanHandle(topic) {
-						err := handler.Handle(ctx, registration.event)
-						if err != nil {
-							fmt.Printf("Error handling message: %v\n", err)
-							continue
-						}
-					}
+	}()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		// Consume returns whenever the group rebalances, so keep rejoining
+		for {
+			if err := eb.ConsumerGroup.Consume(ctx, topics, &consumerGroupHandler{eventBus: eb}); err != nil {
+				if errors.Is(err, sarama.ErrClosedConsumerGroup) {
+					return
 				}
+				eb.logger.Error("consumer group session failed", slog.Any("error", err))
+				time.Sleep(time.Second)
+			}
+			if ctx.Err() != nil {
+				return
 			}
-		}(topic, registration.eventHandler, consumer)
+		}
+	}()
+
+	select {
+	case <-sigchan:
+	case <-ctx.Done():
+	}
+	cancel()
+	<-done
+
+	if err := eb.ConsumerGroup.Close(); err != nil {
+		eb.logger.Error("error closing worker", slog.Any("error", err))
+	}
+}
+
+func (eb *EventBus) dispatch(ctx context.Context, msg *sarama.ConsumerMessage) {
+	ctx = ContextWithEvent(ctx, headerValue(msg, eventIDHeader), msg.Topic)
+
+	registration, ok := eb.eventRegistry[msg.Topic]
+	if !ok {
+		eb.logger.WarnContext(ctx, "no handler registered for topic")
+		return
+	}
+
+	// Decode into a fresh instance, partitions are consumed concurrently
+	event := reflect.New(reflect.TypeOf(registration.event).Elem()).Interface().(Event)
+	if err := json.Unmarshal(msg.Value, event); err != nil {
+		eb.logger.ErrorContext(ctx, "error unmarshaling event", slog.Any("error", err))
+		return
+	}
+
+	if registration.eventHandler.CanHandle(msg.Topic) {
+		if err := registration.eventHandler.Handle(ctx, event); err != nil {
+			eb.logger.ErrorContext(ctx, "error handling message", slog.Any("error", err))
+		}
+	}
+}
+
+// CheckBrokers verifies that the Kafka cluster is reachable by refreshing metadata.
+func (eb *EventBus) CheckBrokers(ctx context.Context) error {
+	if err := eb.Client.RefreshMetadata(); err != nil {
+		return fmt.Errorf("failed to refresh Kafka metadata: %w", err)
+	}
+
+	for _, broker := range eb.Client.Brokers() {
+		if connected, _ := broker.Connected(); connected {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("no connected Kafka brokers")
+}
+
+// CheckConsumerGroup verifies that this instance is a current member of the consumer group.
+func (eb *EventBus) CheckConsumerGroup(ctx context.Context) error {
+	eb.mu.RLock()
+	memberID := eb.memberID
+	eb.mu.RUnlock()
+
+	if memberID == "" {
+		return fmt.Errorf("not a member of consumer group %s", eb.consumerGroup())
+	}
+
+	groups, err := eb.admin.DescribeConsumerGroups([]string{eb.consumerGroup()})
+	if err != nil {
+		return fmt.Errorf("failed to describe consumer group: %w", err)
 	}
 
-	<-sigchan
+	for _, group := range groups {
+		if group.Err != sarama.ErrNoError {
+			return fmt.Errorf("failed to describe consumer group: %w", group.Err)
+		}
+		if _, ok := group.Members[memberID]; ok {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("member %s not found in consumer group %s", memberID, eb.consumerGroup())
+}
+
+func (eb *EventBus) setMemberID(memberID string) {
+	eb.mu.Lock()
+	defer eb.mu.Unlock()
+	eb.memberID = memberID
+}
+
+type consumerGroupHandler struct {
+	eventBus *EventBus
+}
 
-	for _, consumer := range consumers {
-		if err := consumer.Close(); err != nil {
-			fmt.Printf("Error closing consumer: %v\n", err)
+func (h *consumerGroupHandler) Setup(session sarama.ConsumerGroupSession) error {
+	h.eventBus.setMemberID(session.MemberID())
+	h.eventBus.logger.Info("joined consumer group",
+		slog.String("member_id", session.MemberID()),
+		slog.Int("generation", int(session.GenerationID())),
+	)
+	return nil
+}
+
+func (h *consumerGroupHandler) Cleanup(session sarama.ConsumerGroupSession) error {
+	h.eventBus.setMemberID("")
+	return nil
+}
+
+func (h *consumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	for {
+		select {
+		case msg, ok := <-claim.Messages():
+			if !ok {
+				return nil
+			}
+			h.eventBus.dispatch(session.Context(), msg)
+			session.MarkMessage(msg, "")
+		case <-session.Context().Done():
+			return nil
 		}
 	}
+}
 
-	if err := eb.Consumer.Close(); err != nil {
-		fmt.Printf("Error closing worker: %v\n", err)
+func headerValue(msg *sarama.ConsumerMessage, key string) string {
+	for _, header := range msg.Headers {
+		if header != nil && string(header.Key) == key {
+			return string(header.Value)
+		}
 	}
+	return ""
 }
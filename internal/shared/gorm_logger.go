@@ -0,0 +1,96 @@
+package shared
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+	gormlogger "gorm.io/gorm/logger"
+)
+
+// gormLogger routes GORM output through slog. SQL statements are only traced
+// when LOG_LEVEL is debug; slow queries are reported as warnings.
+type gormLogger struct {
+	logger        *slog.Logger
+	level         gormlogger.LogLevel
+	slowThreshold time.Duration
+}
+
+func NewGormLogger(logger *slog.Logger, logLevel string) gormlogger.Interface {
+	return &gormLogger{
+		logger:        logger.With(slog.String("component", "gorm")),
+		level:         gormLogLevel(ParseLogLevel(logLevel)),
+		slowThreshold: time.Second,
+	}
+}
+
+func gormLogLevel(level slog.Level) gormlogger.LogLevel {
+	switch {
+	case level <= slog.LevelDebug:
+		return gormlogger.Info
+	case level <= slog.LevelWarn:
+		return gormlogger.Warn
+	default:
+		return gormlogger.Error
+	}
+}
+
+func (l *gormLogger) LogMode(level gormlogger.LogLevel) gormlogger.Interface {
+	clone := *l
+	clone.level = level
+	return &clone
+}
+
+func (l *gormLogger) Info(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= gormlogger.Info {
+		l.logger.InfoContext(ctx, fmt.Sprintf(msg, data...))
+	}
+}
+
+func (l *gormLogger) Warn(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= gormlogger.Warn {
+		l.logger.WarnContext(ctx, fmt.Sprintf(msg, data...))
+	}
+}
+
+func (l *gormLogger) Error(ctx context.Context, msg string, data ...interface{}) {
+	if l.level >= gormlogger.Error {
+		l.logger.ErrorContext(ctx, fmt.Sprintf(msg, data...))
+	}
+}
+
+func (l *gormLogger) Trace(ctx context.Context, begin time.Time, fc func() (string, int64), err error) {
+	if l.level <= gormlogger.Silent {
+		return
+	}
+
+	elapsed := time.Since(begin)
+	switch {
+	case err != nil && l.level >= gormlogger.Error && !errors.Is(err, gorm.ErrRecordNotFound):
+		sql, rows := fc()
+		l.logger.ErrorContext(ctx, "query failed",
+			slog.String("sql", sql),
+			slog.Int64("rows", rows),
+			slog.Duration("elapsed", elapsed),
+			slog.Any("error", err),
+		)
+	case elapsed > l.slowThreshold && l.level >= gormlogger.Warn:
+		sql, rows := fc()
+		l.logger.WarnContext(ctx, "slow query",
+			slog.String("sql", sql),
+			slog.Int64("rows", rows),
+			slog.Duration("elapsed", elapsed),
+			slog.Duration("threshold", l.slowThreshold),
+		)
+	case l.level >= gormlogger.Info:
+		sql, rows := fc()
+		l.logger.DebugContext(ctx, "query",
+			slog.String("sql", sql),
+			slog.Int64("rows", rows),
+			slog.Duration("elapsed", elapsed),
+		)
+	}
+}
@@ -0,0 +1,148 @@
+package shared
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	requestIDMetadataKey     = "x-request-id"
+	authorizationMetadataKey = "authorization"
+)
+
+// NewGRPCServer returns a gRPC server that tags calls with request IDs,
+// authenticates bearer tokens sent as authorization metadata, bounds unary
+// calls without a deadline by Config.GRPC.DefaultTimeout, translates domain
+// errors into status codes and supports server reflection.
+func NewGRPCServer(logger *slog.Logger, authenticator Authenticator) *grpc.Server {
+	logger = logger.With(slog.String("component", "grpc"))
+
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(unaryServerInterceptor(logger, authenticator)),
+		grpc.ChainStreamInterceptor(streamServerInterceptor(logger, authenticator)),
+	)
+	reflection.Register(server)
+
+	return server
+}
+
+func unaryServerInterceptor(logger *slog.Logger, authenticator Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx = grpcRequestContext(ctx)
+		ctx, err := grpcAuthenticate(ctx, authenticator)
+		if err != nil {
+			return nil, toGRPCError(ctx, logger, err)
+		}
+
+		// The deadline reaches the repositories through ctx
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, Config.GRPC.DefaultTimeout)
+			defer cancel()
+		}
+
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		err = toGRPCError(ctx, logger, err)
+		logGRPCCall(ctx, logger, info.FullMethod, start, err)
+
+		return resp, err
+	}
+}
+
+// streamServerInterceptor leaves streams without a default deadline, they may
+// legitimately run longer than any unary call.
+func streamServerInterceptor(logger *slog.Logger, authenticator Authenticator) grpc.StreamServerInterceptor {
+	return func(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := grpcRequestContext(stream.Context())
+		ctx, err := grpcAuthenticate(ctx, authenticator)
+		if err != nil {
+			return toGRPCError(ctx, logger, err)
+		}
+
+		start := time.Now()
+		err = handler(srv, &contextServerStream{ServerStream: stream, ctx: ctx})
+		err = toGRPCError(ctx, logger, err)
+		logGRPCCall(ctx, logger, info.FullMethod, start, err)
+
+		return err
+	}
+}
+
+type contextServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextServerStream) Context() context.Context {
+	return s.ctx
+}
+
+// grpcRequestContext reuses the caller's x-request-id metadata or generates
+// one, echoes it in the response header and stores it for logging.
+func grpcRequestContext(ctx context.Context) context.Context {
+	var requestID string
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestIDMetadataKey); len(values) > 0 {
+			requestID = values[0]
+		}
+	}
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	_ = grpc.SetHeader(ctx, metadata.Pairs(requestIDMetadataKey, requestID))
+	return ContextWithRequestID(ctx, requestID)
+}
+
+// grpcAuthenticate stores the user ID of a bearer token sent by the caller.
+// Calls without a token go on unauthenticated; methods that need a user check
+// with AuthenticatedUserID.
+func grpcAuthenticate(ctx context.Context, authenticator Authenticator) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(authorizationMetadataKey)
+	if len(values) == 0 {
+		return ctx, nil
+	}
+
+	token, ok := bearerToken(values[0])
+	if !ok {
+		return ctx, NewUnauthenticatedError("authorization metadata must be a bearer token")
+	}
+	principal, err := authenticator.Authenticate(ctx, token)
+	if err != nil {
+		return ctx, err
+	}
+
+	return ContextWithPrincipal(ctx, principal), nil
+}
+
+func toGRPCError(ctx context.Context, logger *slog.Logger, err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	if GRPCCode(err) == codes.Internal {
+		logger.ErrorContext(ctx, "call failed", slog.Any("error", err))
+	}
+	return GRPCError(err)
+}
+
+func logGRPCCall(ctx context.Context, logger *slog.Logger, method string, start time.Time, err error) {
+	logger.DebugContext(ctx, "call handled",
+		slog.String("method", method),
+		slog.String("code", status.Code(err).String()),
+		slog.Duration("elapsed", time.Since(start)),
+	)
+}
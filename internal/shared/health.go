@@ -0,0 +1,138 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	healthStatusOK          = "ok"
+	healthStatusUnavailable = "unavailable"
+)
+
+// HealthCheck returns nil when the dependency it probes is usable.
+type HealthCheck func(ctx context.Context) error
+
+type HealthChecker struct {
+	checks  map[string]HealthCheck
+	timeout time.Duration
+	logger  *slog.Logger
+}
+
+type HealthReport struct {
+	Status string                      `json:"status"`
+	Checks map[string]DependencyHealth `json:"checks,omitempty"`
+}
+
+type DependencyHealth struct {
+	Status    string  `json:"status"`
+	LatencyMS float64 `json:"latency_ms"`
+	Error     string  `json:"error,omitempty"`
+}
+
+func NewHealthChecker(timeout time.Duration, logger *slog.Logger) *HealthChecker {
+	return &HealthChecker{
+		checks:  make(map[string]HealthCheck),
+		timeout: timeout,
+		logger:  logger.With(slog.String("component", "health")),
+	}
+}
+
+func (h *HealthChecker) Register(name string, check HealthCheck) {
+	h.checks[name] = check
+}
+
+// Check runs all registered checks concurrently, each bounded by the checker timeout.
+func (h *HealthChecker) Check(ctx context.Context) HealthReport {
+	report := HealthReport{
+		Status: healthStatusOK,
+		Checks: make(map[string]DependencyHealth, len(h.checks)),
+	}
+
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	for name, check := range h.checks {
+		wg.Add(1)
+		go func(name string, check HealthCheck) {
+			defer wg.Done()
+
+			result := h.run(ctx, check)
+
+			mu.Lock()
+			defer mu.Unlock()
+			report.Checks[name] = result
+			if result.Status != healthStatusOK {
+				report.Status = healthStatusUnavailable
+			}
+		}(name, check)
+	}
+	wg.Wait()
+
+	return report
+}
+
+func (h *HealthChecker) run(ctx context.Context, check HealthCheck) DependencyHealth {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	start := time.Now()
+	errChan := make(chan error, 1)
+	go func() {
+		errChan <- check(ctx)
+	}()
+
+	var err error
+	select {
+	case err = <-errChan:
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+
+	result := DependencyHealth{
+		Status:    healthStatusOK,
+		LatencyMS: float64(time.Since(start).Microseconds()) / 1000,
+	}
+	if err != nil {
+		result.Status = healthStatusUnavailable
+		result.Error = err.Error()
+	}
+
+	return result
+}
+
+// LivenessHandler reports that the process is up without touching dependencies.
+func (h *HealthChecker) LivenessHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		h.write(w, r, http.StatusOK, HealthReport{Status: healthStatusOK})
+	}
+}
+
+// ReadinessHandler answers 503 as soon as any dependency check fails.
+func (h *HealthChecker) ReadinessHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		report := h.Check(r.Context())
+
+		status := http.StatusOK
+		if report.Status != healthStatusOK {
+			status = http.StatusServiceUnavailable
+			h.logger.WarnContext(r.Context(), "readiness check failed", slog.Any("checks", report.Checks))
+		}
+
+		h.write(w, r, status, report)
+	}
+}
+
+func (h *HealthChecker) write(w http.ResponseWriter, r *http.Request, status int, report HealthReport) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		h.logger.ErrorContext(r.Context(), "failed to write health report", slog.Any("error", err))
+	}
+}
@@ -0,0 +1,247 @@
+package shared
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	requestIDHeader = "X-Request-ID"
+	maxBodyBytes    = 1 << 20
+
+	DefaultPageLimit = 10
+	MaxPageLimit     = 100
+)
+
+// ErrorResponse is the body of every non-2xx API response.
+type ErrorResponse struct {
+	Error ErrorBody `json:"error"`
+}
+
+type ErrorBody struct {
+	Code      string            `json:"code"`
+	Message   string            `json:"message"`
+	Fields    map[string]string `json:"fields,omitempty"`
+	RequestID string            `json:"request_id,omitempty"`
+}
+
+func NewHTTPServer(handler http.Handler, logger *slog.Logger) *http.Server {
+	logger = logger.With(slog.String("component", "http"))
+
+	return &http.Server{
+		Addr:         fmt.Sprintf(":%d", Config.HTTP.Port),
+		Handler:      withRequestID(withAccessLog(handler, logger)),
+		ReadTimeout:  Config.HTTP.ReadTimeout,
+		WriteTimeout: Config.HTTP.WriteTimeout,
+		ErrorLog:     slog.NewLogLogger(logger.Handler(), slog.LevelError),
+	}
+}
+
+// withRequestID reuses the caller's X-Request-ID or generates one, echoes it
+// back and stores it in the request context for logging.
+func withRequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(requestIDHeader)
+		if requestID == "" {
+			requestID = uuid.New().String()
+		}
+
+		w.Header().Set(requestIDHeader, requestID)
+		next.ServeHTTP(w, r.WithContext(ContextWithRequestID(r.Context(), requestID)))
+	})
+}
+
+func withAccessLog(next http.Handler, logger *slog.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+
+		next.ServeHTTP(recorder, r)
+
+		logger.DebugContext(r.Context(), "request handled",
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.Int("status", recorder.status),
+			slog.Duration("elapsed", time.Since(start)),
+		)
+	})
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func WriteJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if body == nil {
+		return
+	}
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		Logger.Error("failed to write response", slog.Any("error", err))
+	}
+}
+
+func WriteError(w http.ResponseWriter, r *http.Request, status int, message string, fields map[string]string) {
+	WriteJSON(w, status, ErrorResponse{
+		Error: ErrorBody{
+			Code:      errorCode(status),
+			Message:   message,
+			Fields:    fields,
+			RequestID: RequestIDFromContext(r.Context()),
+		},
+	})
+}
+
+func errorCode(status int) string {
+	switch status {
+	case http.StatusBadRequest:
+		return "invalid_request"
+	case http.StatusUnauthorized:
+		return "unauthenticated"
+	case http.StatusForbidden:
+		return "forbidden"
+	case http.StatusNotFound:
+		return "not_found"
+	case http.StatusConflict:
+		return "conflict"
+	case http.StatusUnprocessableEntity:
+		return "validation_failed"
+	case http.StatusGatewayTimeout:
+		return "timeout"
+	default:
+		return "internal_error"
+	}
+}
+
+// DecodeJSON decodes a single JSON object from the request body, rejecting
+// unknown fields and bodies over 1 MiB.
+func DecodeJSON(w http.ResponseWriter, r *http.Request, dst interface{}) error {
+	decoder := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	decoder.DisallowUnknownFields()
+
+	if err := decoder.Decode(dst); err != nil {
+		if errors.Is(err, io.EOF) {
+			return fmt.Errorf("request body must not be empty")
+		}
+		return fmt.Errorf("invalid JSON body: %w", err)
+	}
+	if decoder.More() {
+		return fmt.Errorf("request body must contain a single JSON object")
+	}
+
+	return nil
+}
+
+// QueryInt reads an integer query parameter, returning defaultValue when it is absent.
+func QueryInt(r *http.Request, key string, defaultValue int) (int, error) {
+	value := r.URL.Query().Get(key)
+	if value == "" {
+		return defaultValue, nil
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("query parameter %s must be an integer", key)
+	}
+
+	return parsed, nil
+}
+
+// ParsePagination reads the limit and offset query parameters, writing a 400
+// response and returning false when they are malformed or out of range.
+func ParsePagination(w http.ResponseWriter, r *http.Request) (int, int, bool) {
+	limit, err := QueryInt(r, "limit", DefaultPageLimit)
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return 0, 0, false
+	}
+	offset, err := QueryInt(r, "offset", 0)
+	if err != nil {
+		WriteError(w, r, http.StatusBadRequest, err.Error(), nil)
+		return 0, 0, false
+	}
+
+	if limit <= 0 || limit > MaxPageLimit || offset < 0 {
+		WriteError(w, r, http.StatusBadRequest,
+			fmt.Sprintf("limit must be between 1 and %d and offset must not be negative", MaxPageLimit), nil)
+		return 0, 0, false
+	}
+
+	return limit, offset, true
+}
+
+// ValidatePagination applies the rules of ParsePagination to the limit and
+// offset of a gRPC or GraphQL request, where an unset limit means DefaultPageLimit.
+func ValidatePagination(limit, offset int32) (int, int, error) {
+	if limit == 0 {
+		limit = DefaultPageLimit
+	}
+	if limit < 0 || limit > MaxPageLimit {
+		return 0, 0, NewFieldError("limit", fmt.Sprintf("must be between 1 and %d", MaxPageLimit))
+	}
+	if offset < 0 {
+		return 0, 0, NewFieldError("offset", "must not be negative")
+	}
+
+	return int(limit), int(offset), nil
+}
+
+// ParsePageRequest reads the limit, offset and cursor query parameters with
+// the rules of ParsePagination, writing a 400 response and returning false
+// when they are invalid.
+func ParsePageRequest(w http.ResponseWriter, r *http.Request) (PageRequest, bool) {
+	limit, offset, ok := ParsePagination(w, r)
+	if !ok {
+		return PageRequest{}, false
+	}
+
+	cursor := r.URL.Query().Get("cursor")
+	if cursor != "" && offset > 0 {
+		WriteError(w, r, http.StatusBadRequest, "cursor and offset cannot be combined", nil)
+		return PageRequest{}, false
+	}
+
+	return PageRequest{Limit: limit, Offset: offset, Cursor: cursor}, true
+}
+
+// ValidatePageRequest is ParsePageRequest for gRPC and GraphQL requests.
+func ValidatePageRequest(limit, offset int32, cursor string) (PageRequest, error) {
+	validLimit, validOffset, err := ValidatePagination(limit, offset)
+	if err != nil {
+		return PageRequest{}, err
+	}
+	if cursor != "" && validOffset > 0 {
+		return PageRequest{}, NewFieldError("offset", "cannot be combined with a cursor")
+	}
+
+	return PageRequest{Limit: validLimit, Offset: validOffset, Cursor: cursor}, nil
+}
+
+// PathObjectID reads a path value that has to be a MongoDB ObjectID, writing a
+// 400 response and returning false when it is not.
+func PathObjectID(w http.ResponseWriter, r *http.Request, name string) (string, bool) {
+	id := r.PathValue(name)
+	if !primitive.IsValidObjectID(id) {
+		WriteError(w, r, http.StatusBadRequest,
+			fmt.Sprintf("path parameter %s must be a 24 character hex ObjectID", name), nil)
+		return "", false
+	}
+
+	return id, true
+}
@@ -0,0 +1,97 @@
+package shared
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+// Logger is the application-wide structured logger configured from Config.App.
+var Logger *slog.Logger
+
+type contextKey string
+
+const (
+	requestIDKey contextKey = "request_id"
+	eventIDKey   contextKey = "event_id"
+	eventTypeKey contextKey = "event_type"
+)
+
+// NewLogger builds a slog logger writing JSON in production and text otherwise,
+// at the level configured by LOG_LEVEL.
+func NewLogger(conf AppConfig) *slog.Logger {
+	opts := &slog.HandlerOptions{
+		Level: ParseLogLevel(conf.LogLevel),
+	}
+
+	var handler slog.Handler
+	if conf.Environment == "production" {
+		handler = slog.NewJSONHandler(os.Stdout, opts)
+	} else {
+		handler = slog.NewTextHandler(os.Stdout, opts)
+	}
+
+	return slog.New(&contextHandler{Handler: handler})
+}
+
+// ParseLogLevel maps LOG_LEVEL values to slog levels, defaulting to info.
+func ParseLogLevel(level string) slog.Level {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+func ContextWithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey, requestID)
+}
+
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey).(string)
+	return requestID
+}
+
+func ContextWithEvent(ctx context.Context, eventID, eventType string) context.Context {
+	ctx = context.WithValue(ctx, eventIDKey, eventID)
+	return context.WithValue(ctx, eventTypeKey, eventType)
+}
+
+func EventIDFromContext(ctx context.Context) string {
+	eventID, _ := ctx.Value(eventIDKey).(string)
+	return eventID
+}
+
+// contextHandler attaches request and event identifiers found in the context
+// to every record, so callers only need to use the *Context logging methods.
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, record slog.Record) error {
+	if requestID := RequestIDFromContext(ctx); requestID != "" {
+		record.AddAttrs(slog.String(string(requestIDKey), requestID))
+	}
+	if eventID := EventIDFromContext(ctx); eventID != "" {
+		record.AddAttrs(slog.String(string(eventIDKey), eventID))
+	}
+	if eventType, _ := ctx.Value(eventTypeKey).(string); eventType != "" {
+		record.AddAttrs(slog.String(string(eventTypeKey), eventType))
+	}
+
+	return h.Handler.Handle(ctx, record)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
@@ -0,0 +1,330 @@
+package shared
+
+import (
+	"encoding"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	openAPIVersion     = "3.0.3"
+	bearerSecurityName = "bearerAuth"
+)
+
+var pathParamPattern = regexp.MustCompile(`\{([A-Za-z0-9_]+)\}`)
+
+// OpenAPIDocument is the subset of the OpenAPI 3.0 object model the API uses.
+type OpenAPIDocument struct {
+	OpenAPI    string                           `json:"openapi"`
+	Info       OpenAPIInfo                      `json:"info"`
+	Paths      map[string]map[string]*Operation `json:"paths"`
+	Components Components                       `json:"components"`
+}
+
+type OpenAPIInfo struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type Operation struct {
+	Tags        []string              `json:"tags,omitempty"`
+	Summary     string                `json:"summary,omitempty"`
+	Parameters  []Parameter           `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required"`
+	Schema      *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                  `json:"required"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+}
+
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+// NewOpenAPIDocument describes routes and, by reflection over their request
+// and response values, the JSON types they exchange.
+func NewOpenAPIDocument(info OpenAPIInfo, routes []Route) *OpenAPIDocument {
+	generator := newSchemaGenerator()
+	errorSchema := generator.schemaFor(reflect.TypeOf(ErrorResponse{}))
+
+	document := &OpenAPIDocument{
+		OpenAPI: openAPIVersion,
+		Info:    info,
+		Paths:   make(map[string]map[string]*Operation),
+	}
+
+	for _, route := range routes {
+		operation := &Operation{
+			Summary:   route.Summary,
+			Responses: make(map[string]*Response),
+		}
+		if route.Tag != "" {
+			operation.Tags = []string{route.Tag}
+		}
+
+		for _, match := range pathParamPattern.FindAllStringSubmatch(route.Path, -1) {
+			operation.Parameters = append(operation.Parameters, Parameter{
+				Name:     match[1],
+				In:       "path",
+				Required: true,
+				Schema:   &Schema{Type: "string"},
+			})
+		}
+		for _, param := range route.Query {
+			paramType := param.Type
+			if paramType == "" {
+				paramType = "string"
+			}
+			operation.Parameters = append(operation.Parameters, Parameter{
+				Name:        param.Name,
+				In:          "query",
+				Description: param.Description,
+				Required:    param.Required,
+				Schema:      &Schema{Type: paramType},
+			})
+		}
+
+		if route.Request != nil {
+			operation.RequestBody = &RequestBody{
+				Required: true,
+				Content:  jsonContent(generator.schemaFor(reflect.TypeOf(route.Request))),
+			}
+		}
+
+		success := &Response{Description: http.StatusText(route.Status)}
+		if route.Response != nil {
+			success.Content = jsonContent(generator.schemaFor(reflect.TypeOf(route.Response)))
+		}
+		operation.Responses[strconv.Itoa(route.Status)] = success
+
+		errorStatuses := append([]int(nil), route.Errors...)
+		if route.Auth {
+			operation.Security = []map[string][]string{{bearerSecurityName: {}}}
+			errorStatuses = append(errorStatuses, http.StatusUnauthorized)
+			document.Components.SecuritySchemes = map[string]*SecurityScheme{
+				bearerSecurityName: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			}
+		}
+		for _, status := range append(errorStatuses, http.StatusInternalServerError) {
+			operation.Responses[strconv.Itoa(status)] = &Response{
+				Description: http.StatusText(status),
+				Content:     jsonContent(errorSchema),
+			}
+		}
+
+		if document.Paths[route.Path] == nil {
+			document.Paths[route.Path] = make(map[string]*Operation)
+		}
+		document.Paths[route.Path][strings.ToLower(route.Method)] = operation
+	}
+
+	document.Components.Schemas = generator.schemas
+	return document
+}
+
+// OpenAPIHandler serves the document for all routes registered on the router.
+// It is built on the first request, after every route has been registered.
+func (r *Router) OpenAPIHandler(info OpenAPIInfo) http.HandlerFunc {
+	var (
+		once     sync.Once
+		document *OpenAPIDocument
+	)
+
+	return func(w http.ResponseWriter, req *http.Request) {
+		once.Do(func() {
+			document = NewOpenAPIDocument(info, r.Routes())
+		})
+		WriteJSON(w, http.StatusOK, document)
+	}
+}
+
+func jsonContent(schema *Schema) map[string]*MediaType {
+	return map[string]*MediaType{"application/json": {Schema: schema}}
+}
+
+var (
+	timeType        = reflect.TypeOf(time.Time{})
+	objectIDType    = reflect.TypeOf(primitive.ObjectID{})
+	marshalerType   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// schemaGenerator turns Go types into schemas following encoding/json rules.
+// Named structs become components referenced by $ref.
+type schemaGenerator struct {
+	schemas map[string]*Schema
+	names   map[reflect.Type]string
+}
+
+func newSchemaGenerator() *schemaGenerator {
+	return &schemaGenerator{
+		schemas: make(map[string]*Schema),
+		names:   make(map[reflect.Type]string),
+	}
+}
+
+func (g *schemaGenerator) schemaFor(t reflect.Type) *Schema {
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case objectIDType:
+		return &Schema{Type: "string", Pattern: "^[0-9a-f]{24}$"}
+	}
+
+	// Custom encodings, e.g. json.RawMessage, can be any JSON value
+	if t.Kind() != reflect.Pointer && (t.Implements(marshalerType) || t.Implements(textMarshalType)) {
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		schema := g.schemaFor(t.Elem())
+		if schema.Ref != "" {
+			return schema
+		}
+		schema.Nullable = true
+		return schema
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: g.schemaFor(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: g.schemaFor(t.Elem())}
+	case reflect.Struct:
+		return g.structSchema(t)
+	default:
+		// interface{} and anything else encoding/json handles dynamically
+		return &Schema{}
+	}
+}
+
+func (g *schemaGenerator) structSchema(t reflect.Type) *Schema {
+	if t.Name() == "" {
+		return g.objectSchema(t)
+	}
+
+	name, ok := g.names[t]
+	if !ok {
+		name = g.componentName(t)
+		g.names[t] = name
+		// Reserve the name before descending, for recursive types
+		g.schemas[name] = &Schema{}
+		*g.schemas[name] = *g.objectSchema(t)
+	}
+
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// componentName is the Go type name, prefixed with its package name when
+// another package already used it.
+func (g *schemaGenerator) componentName(t reflect.Type) string {
+	if _, taken := g.schemas[t.Name()]; !taken {
+		return t.Name()
+	}
+
+	pkg := t.PkgPath()[strings.LastIndex(t.PkgPath(), "/")+1:]
+	return strings.ToUpper(pkg[:1]) + pkg[1:] + t.Name()
+}
+
+func (g *schemaGenerator) objectSchema(t reflect.Type) *Schema {
+	schema := &Schema{Type: "object", Properties: make(map[string]*Schema)}
+	g.addFields(schema, t)
+	return schema
+}
+
+func (g *schemaGenerator) addFields(schema *Schema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, options, _ := strings.Cut(tag, ",")
+
+		// Embedded structs without a name of their own are flattened by encoding/json
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				g.addFields(schema, embedded)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+
+		// Fields that are always encoded are required, as are request fields
+		// tagged openapi:"required" (e.g. pointers that tell absent from zero)
+		schema.Properties[name] = g.schemaFor(field.Type)
+		alwaysEncoded := !strings.Contains(options, "omitempty") && field.Type.Kind() != reflect.Pointer
+		if alwaysEncoded || field.Tag.Get("openapi") == "required" {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+}
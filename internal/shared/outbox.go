@@ -0,0 +1,137 @@
+package shared
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// OutboxMessage is an event stored in PostgreSQL in the same transaction as the
+// change it describes, and relayed to Kafka afterwards.
+type OutboxMessage struct {
+	ID          string          `gorm:"primaryKey;type:varchar(36)"`
+	EventType   string          `gorm:"type:varchar(100);not null"`
+	Payload     json.RawMessage `gorm:"type:jsonb;not null"`
+	CreatedAt   time.Time       `gorm:"autoCreateTime"`
+	PublishedAt *time.Time
+}
+
+func (OutboxMessage) TableName() string {
+	return "outbox_events"
+}
+
+type Outbox struct {
+	db *gorm.DB
+}
+
+// NewOutbox returns an outbox writing through db, which is usually a
+// transaction handed out by a UnitOfWork.
+func NewOutbox(db *gorm.DB) *Outbox {
+	return &Outbox{db: db}
+}
+
+func (o *Outbox) Add(ctx context.Context, event Event) error {
+	payload, err := json.Marshal(event.GetPayload())
+	if err != nil {
+		return fmt.Errorf("failed to marshal outbox event: %w", err)
+	}
+
+	message := &OutboxMessage{
+		ID:        event.GetID(),
+		EventType: event.GetType(),
+		Payload:   payload,
+		CreatedAt: event.GetTimestamp(),
+	}
+	if err := o.db.WithContext(ctx).Create(message).Error; err != nil {
+		return fmt.Errorf("failed to store outbox event: %w", err)
+	}
+
+	return nil
+}
+
+// OutboxRelay periodically publishes pending outbox messages to Kafka. Delivery
+// is at least once: a batch that fails midway is retried from its first message.
+type OutboxRelay struct {
+	db        *gorm.DB
+	eventBus  *EventBus
+	interval  time.Duration
+	batchSize int
+	logger    *slog.Logger
+}
+
+func NewOutboxRelay(db *gorm.DB, eventBus *EventBus, logger *slog.Logger) *OutboxRelay {
+	return &OutboxRelay{
+		db:        db,
+		eventBus:  eventBus,
+		interval:  time.Second,
+		batchSize: 100,
+		logger:    logger.With(slog.String("component", "outbox_relay")),
+	}
+}
+
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for {
+				relayed, err := r.relayBatch(ctx)
+				if err != nil {
+					r.logger.ErrorContext(ctx, "failed to relay outbox events", slog.Any("error", err))
+					break
+				}
+				if relayed < r.batchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// relayBatch locks a batch of pending messages so that other instances skip
+// them, publishes them in order and marks them as published.
+func (r *OutboxRelay) relayBatch(ctx context.Context) (int, error) {
+	relayed := 0
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var messages []OutboxMessage
+		if err := tx.
+			Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("published_at IS NULL").
+			Order("created_at").
+			Limit(r.batchSize).
+			Find(&messages).Error; err != nil {
+			return fmt.Errorf("failed to load outbox events: %w", err)
+		}
+
+		for _, message := range messages {
+			if err := r.eventBus.pushMessageToQueue(message.ID, message.EventType, message.Payload); err != nil {
+				return fmt.Errorf("failed to publish outbox event %s: %w", message.ID, err)
+			}
+			if err := tx.Model(&OutboxMessage{}).
+				Where("id = ?", message.ID).
+				Update("published_at", time.Now()).Error; err != nil {
+				return fmt.Errorf("failed to mark outbox event %s as published: %w", message.ID, err)
+			}
+
+			r.logger.DebugContext(ctx, "relayed outbox event",
+				slog.String("event_id", message.ID),
+				slog.String("event_type", message.EventType),
+			)
+			relayed++
+		}
+
+		return nil
+	})
+
+	return relayed, err
+}
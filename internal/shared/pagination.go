@@ -0,0 +1,183 @@
+package shared
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// PageRequest selects a page of a list. A cursor from a previous page is the
+// stable way to page; Offset is kept for clients that jump to a position and
+// cannot be combined with a cursor.
+type PageRequest struct {
+	Limit  int
+	Offset int
+	Cursor string
+}
+
+// WithDefaults replaces a missing limit and a negative offset.
+func (p PageRequest) WithDefaults() PageRequest {
+	if p.Limit <= 0 {
+		p.Limit = DefaultPageLimit
+	}
+	if p.Offset < 0 {
+		p.Offset = 0
+	}
+	return p
+}
+
+// Direction tells where a page lies relative to the cursor it was requested with.
+type Direction int
+
+const (
+	// FromStart pages without a cursor, skipping Offset items.
+	FromStart Direction = iota
+	// After returns the items following the cursor's item.
+	After
+	// Before returns the items preceding the cursor's item.
+	Before
+)
+
+var errInvalidCursor = NewFieldError("cursor", "is not a cursor of this list")
+
+// cursorToken is the content of an opaque cursor: the sort key of the item
+// it was taken from and the side of it the next page lies on.
+type cursorToken struct {
+	Before bool            `json:"b,omitempty"`
+	Key    json.RawMessage `json:"k"`
+}
+
+// Seek decodes the request's cursor into key, which must be a pointer to the
+// list's key type, and reports which way to page from it.
+func (p PageRequest) Seek(key interface{}) (Direction, error) {
+	if p.Cursor == "" {
+		return FromStart, nil
+	}
+
+	raw, err := base64.RawURLEncoding.DecodeString(p.Cursor)
+	if err != nil {
+		return FromStart, errInvalidCursor
+	}
+	var token cursorToken
+	// A null key would decode to the zero key without an error
+	if err := json.Unmarshal(raw, &token); err != nil || len(token.Key) == 0 || string(token.Key) == "null" {
+		return FromStart, errInvalidCursor
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(token.Key))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(key); err != nil {
+		return FromStart, errInvalidCursor
+	}
+
+	if token.Before {
+		return Before, nil
+	}
+	return After, nil
+}
+
+func encodeCursor(direction Direction, key interface{}) string {
+	// Keys are plain structs of strings, numbers and times, which always marshal
+	rawKey, _ := json.Marshal(key)
+	raw, _ := json.Marshal(cursorToken{Before: direction == Before, Key: rawKey})
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+// Page is one page of a list with the cursors of its neighbours, which are
+// empty at either end of the list.
+type Page[T any] struct {
+	Items      []T
+	NextCursor string
+	PrevCursor string
+}
+
+// NewPage builds a page from the rows a repository read for page in the
+// given direction: up to Limit+1 rows, in reverse order when paging Before.
+// keyOf returns the sort key of an item, which goes into the cursors.
+func NewPage[T any](rows []T, page PageRequest, direction Direction, keyOf func(T) interface{}) *Page[T] {
+	hasExtra := len(rows) > page.Limit
+	if hasExtra {
+		rows = rows[:page.Limit]
+	}
+	if direction == Before {
+		slices.Reverse(rows)
+	}
+	if rows == nil {
+		rows = []T{}
+	}
+
+	result := &Page[T]{Items: rows}
+	if len(rows) == 0 {
+		return result
+	}
+
+	hasNext := hasExtra
+	hasPrev := direction == After || (direction == FromStart && page.Offset > 0)
+	if direction == Before {
+		hasNext, hasPrev = true, hasExtra
+	}
+	if hasNext {
+		result.NextCursor = encodeCursor(After, keyOf(rows[len(rows)-1]))
+	}
+	if hasPrev {
+		result.PrevCursor = encodeCursor(Before, keyOf(rows[0]))
+	}
+
+	return result
+}
+
+// KeysetPage restricts query to the requested page of a list ordered by
+// columns, all descending, the last of which must be unique. values are the
+// column values of the cursor's key and are ignored without a cursor.
+func KeysetPage(query *gorm.DB, page PageRequest, direction Direction, columns []string, values ...interface{}) *gorm.DB {
+	order := "DESC"
+	if direction == Before {
+		order = "ASC"
+	}
+	for _, column := range columns {
+		query = query.Order(column + " " + order)
+	}
+
+	switch direction {
+	case After:
+		query = query.Where(fmt.Sprintf("(%s) < (%s)", strings.Join(columns, ", "), placeholders(len(columns))), values...)
+	case Before:
+		query = query.Where(fmt.Sprintf("(%s) > (%s)", strings.Join(columns, ", "), placeholders(len(columns))), values...)
+	default:
+		query = query.Offset(page.Offset)
+	}
+
+	// One row more than requested tells whether the list goes on
+	return query.Limit(page.Limit + 1)
+}
+
+func placeholders(count int) string {
+	return strings.TrimSuffix(strings.Repeat("?, ", count), ", ")
+}
+
+// Pagination describes a page in list responses. Pass next_cursor or
+// prev_cursor back as the cursor parameter to get the neighbouring page.
+type Pagination struct {
+	Limit      int    `json:"limit"`
+	Offset     int    `json:"offset"`
+	Count      int    `json:"count"`
+	HasMore    bool   `json:"has_more"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	PrevCursor string `json:"prev_cursor,omitempty"`
+}
+
+func PaginationOf[T any](page *Page[T], request PageRequest) Pagination {
+	return Pagination{
+		Limit:      request.Limit,
+		Offset:     request.Offset,
+		Count:      len(page.Items),
+		HasMore:    page.NextCursor != "",
+		NextCursor: page.NextCursor,
+		PrevCursor: page.PrevCursor,
+	}
+}
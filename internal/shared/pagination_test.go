@@ -0,0 +1,244 @@
+package shared
+
+import (
+	"encoding/base64"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
+
+type testKey struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        int       `json:"id"`
+}
+
+func keyOf(id int) interface{} {
+	return testKey{CreatedAt: time.Date(2024, 1, 1, 0, 0, id, 0, time.UTC), ID: id}
+}
+
+func TestSeekWithoutCursor(t *testing.T) {
+	var key testKey
+	direction, err := PageRequest{Limit: 10, Offset: 20}.Seek(&key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if direction != FromStart {
+		t.Errorf("got direction %d, want FromStart", direction)
+	}
+}
+
+func TestSeekDecodesCursors(t *testing.T) {
+	for _, want := range []Direction{After, Before} {
+		cursor := encodeCursor(want, keyOf(7))
+
+		var key testKey
+		direction, err := PageRequest{Limit: 10, Cursor: cursor}.Seek(&key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if direction != want {
+			t.Errorf("got direction %d, want %d", direction, want)
+		}
+		if key != keyOf(7) {
+			t.Errorf("got key %+v, want %+v", key, keyOf(7))
+		}
+	}
+}
+
+func TestSeekRejectsTamperedCursors(t *testing.T) {
+	encode := func(raw string) string {
+		return base64.RawURLEncoding.EncodeToString([]byte(raw))
+	}
+	valid := encodeCursor(After, keyOf(7))
+
+	tests := map[string]string{
+		"not base64":        "not a cursor!",
+		"padded base64":     valid + "==",
+		"not JSON":          encode("created_at=2024"),
+		"without key":       encode(`{"b":true}`),
+		"null key":          encode(`{"k":null}`),
+		"unknown key field": encode(`{"k":{"id":7,"title":"Alien"}}`),
+		"wrong key type":    encode(`{"k":{"id":"seven"}}`),
+		"truncated":         valid[:len(valid)-4],
+	}
+
+	for name, cursor := range tests {
+		t.Run(name, func(t *testing.T) {
+			var key testKey
+			_, err := PageRequest{Limit: 10, Cursor: cursor}.Seek(&key)
+			if !errors.Is(err, ErrValidation) {
+				t.Errorf("got %v, want a validation error", err)
+			}
+		})
+	}
+}
+
+// rows returns the rows a repository reads for a page: the IDs in list
+// order, or reversed when paging Before.
+func rows(direction Direction, ids ...int) []int {
+	if direction == Before {
+		slices.Reverse(ids)
+	}
+	return ids
+}
+
+func TestNewPage(t *testing.T) {
+	tests := []struct {
+		name      string
+		page      PageRequest
+		direction Direction
+		rows      []int
+		items     []int
+		next      interface{}
+		prev      interface{}
+	}{
+		{
+			name:  "first page",
+			page:  PageRequest{Limit: 3},
+			rows:  rows(FromStart, 1, 2, 3, 4),
+			items: []int{1, 2, 3},
+			next:  keyOf(3),
+		},
+		{
+			name:  "only page",
+			page:  PageRequest{Limit: 3},
+			rows:  rows(FromStart, 1, 2),
+			items: []int{1, 2},
+		},
+		{
+			name:  "page at an offset",
+			page:  PageRequest{Limit: 3, Offset: 3},
+			rows:  rows(FromStart, 4, 5, 6, 7),
+			items: []int{4, 5, 6},
+			next:  keyOf(6),
+			prev:  keyOf(4),
+		},
+		{
+			name:      "page after a cursor",
+			page:      PageRequest{Limit: 3},
+			direction: After,
+			rows:      rows(After, 4, 5, 6, 7),
+			items:     []int{4, 5, 6},
+			next:      keyOf(6),
+			prev:      keyOf(4),
+		},
+		{
+			name:      "last page",
+			page:      PageRequest{Limit: 3},
+			direction: After,
+			rows:      rows(After, 7, 8, 9),
+			items:     []int{7, 8, 9},
+			prev:      keyOf(7),
+		},
+		{
+			name:      "page before a cursor",
+			page:      PageRequest{Limit: 3},
+			direction: Before,
+			rows:      rows(Before, 3, 4, 5, 6),
+			items:     []int{4, 5, 6},
+			next:      keyOf(6),
+			prev:      keyOf(4),
+		},
+		{
+			name:      "first page before a cursor",
+			page:      PageRequest{Limit: 3},
+			direction: Before,
+			rows:      rows(Before, 1, 2),
+			items:     []int{1, 2},
+			next:      keyOf(2),
+		},
+		{
+			name:      "past the end",
+			page:      PageRequest{Limit: 3},
+			direction: After,
+			items:     []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page := NewPage(tt.rows, tt.page, tt.direction, keyOf)
+
+			if !slices.Equal(page.Items, tt.items) || page.Items == nil {
+				t.Errorf("got items %v, want %v", page.Items, tt.items)
+			}
+			checkCursor(t, "next", page.NextCursor, After, tt.next)
+			checkCursor(t, "prev", page.PrevCursor, Before, tt.prev)
+		})
+	}
+}
+
+func checkCursor(t *testing.T, name, cursor string, direction Direction, want interface{}) {
+	t.Helper()
+
+	if want == nil {
+		if cursor != "" {
+			t.Errorf("got a %s cursor, want none", name)
+		}
+		return
+	}
+	if cursor == "" {
+		t.Errorf("got no %s cursor", name)
+		return
+	}
+
+	var key testKey
+	got, err := PageRequest{Cursor: cursor}.Seek(&key)
+	if err != nil {
+		t.Fatalf("%s cursor: %v", name, err)
+	}
+	if got != direction || key != want {
+		t.Errorf("%s cursor pages %d from %+v, want %d from %+v", name, got, key, direction, want)
+	}
+}
+
+func TestPagingBackAndForth(t *testing.T) {
+	list := []int{1, 2, 3, 4, 5, 6, 7}
+	page := PageRequest{Limit: 3}
+
+	// read is a repository reading the list in keyset order
+	read := func(request PageRequest) *Page[int] {
+		var key testKey
+		direction, err := request.Seek(&key)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var found []int
+		switch direction {
+		case FromStart:
+			found = slices.Clone(list[request.Offset:])
+		case After:
+			found = slices.Clone(list[slices.Index(list, key.ID)+1:])
+		case Before:
+			found = rows(Before, slices.Clone(list[:slices.Index(list, key.ID)])...)
+		}
+		return NewPage(found[:min(len(found), request.Limit+1)], request, direction, keyOf)
+	}
+
+	first := read(page)
+	second := read(PageRequest{Limit: 3, Cursor: first.NextCursor})
+	last := read(PageRequest{Limit: 3, Cursor: second.NextCursor})
+	back := read(PageRequest{Limit: 3, Cursor: second.PrevCursor})
+
+	for name, got := range map[string]struct {
+		page *Page[int]
+		want []int
+	}{
+		"first":         {first, []int{1, 2, 3}},
+		"second":        {second, []int{4, 5, 6}},
+		"last":          {last, []int{7}},
+		"back to first": {back, []int{1, 2, 3}},
+	} {
+		if !slices.Equal(got.page.Items, got.want) {
+			t.Errorf("%s page has %v, want %v", name, got.page.Items, got.want)
+		}
+	}
+	if last.NextCursor != "" {
+		t.Error("the last page has a next cursor")
+	}
+	if back.PrevCursor != "" {
+		t.Error("the first page paged back to has a prev cursor")
+	}
+}
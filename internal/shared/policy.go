@@ -0,0 +1,97 @@
+package shared
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+)
+
+// Role decides what a user may do besides managing their own data.
+type Role string
+
+const (
+	RoleUser    Role = "user"
+	RoleCurator Role = "curator" // maintains the movie catalog and moderates ratings
+	RoleAdmin   Role = "admin"
+)
+
+var Roles = []Role{RoleUser, RoleCurator, RoleAdmin}
+
+func (r Role) Valid() bool {
+	return slices.Contains(Roles, r)
+}
+
+// Action is an operation guarded by the policy.
+type Action string
+
+const (
+	ActionWriteCatalog   Action = "catalog.write"
+	ActionReadUser       Action = "user.read"
+	ActionListUsers      Action = "user.list"
+	ActionUpdateUser     Action = "user.update"
+	ActionDeleteUser     Action = "user.delete"
+	ActionChangeUserRole Action = "user.change_role"
+	// ActionReadUserData and ActionModifyUserData cover the watchlist, watch
+	// history and ratings.
+	ActionReadUserData   Action = "user_data.read"
+	ActionModifyUserData Action = "user_data.modify"
+	ActionRemoveRating   Action = "rating.remove"
+)
+
+// rule grants an action to the listed roles and, for actions on a user's
+// resources, to the owner.
+type rule struct {
+	roles []Role
+	owner bool
+}
+
+var policy = map[Action]rule{
+	ActionWriteCatalog:   {roles: []Role{RoleCurator, RoleAdmin}},
+	ActionReadUser:       {roles: []Role{RoleAdmin}, owner: true},
+	ActionListUsers:      {roles: []Role{RoleAdmin}},
+	ActionUpdateUser:     {roles: []Role{RoleAdmin}, owner: true},
+	ActionDeleteUser:     {roles: []Role{RoleAdmin}, owner: true},
+	ActionChangeUserRole: {roles: []Role{RoleAdmin}},
+	ActionReadUserData:   {roles: []Role{RoleAdmin}, owner: true},
+	ActionModifyUserData: {owner: true},
+	// Removing another user's rating is moderation
+	ActionRemoveRating: {roles: []Role{RoleCurator, RoleAdmin}, owner: true},
+}
+
+// Authorize checks that the caller in ctx may perform action on a resource
+// belonging to ownerID, which is empty for resources nobody owns. Denied
+// attempts are written to the audit log.
+func Authorize(ctx context.Context, action Action, ownerID string) error {
+	principal, ok := PrincipalFromContext(ctx)
+	if !ok {
+		return errAuthenticationRequired
+	}
+	if principal.may(action, ownerID) {
+		return nil
+	}
+
+	Logger.WarnContext(ctx, "access denied",
+		slog.Bool("audit", true),
+		slog.String("user_id", principal.UserID),
+		slog.String("role", string(principal.Role)),
+		slog.String("action", string(action)),
+		slog.String("owner_id", ownerID),
+	)
+	return NewForbiddenError("not allowed to perform " + string(action))
+}
+
+// Permits reports whether the caller in ctx may perform action on a resource
+// belonging to ownerID, without auditing a denial. It serves to leave out
+// what the caller may not see rather than to refuse a request.
+func Permits(ctx context.Context, action Action, ownerID string) bool {
+	principal, ok := PrincipalFromContext(ctx)
+	return ok && principal.may(action, ownerID)
+}
+
+func (p Principal) may(action Action, ownerID string) bool {
+	rule := policy[action]
+	if rule.owner && ownerID != "" && p.UserID == ownerID {
+		return true
+	}
+	return slices.Contains(rule.roles, p.Role)
+}
@@ -0,0 +1,78 @@
+package shared
+
+import (
+	"net/http"
+)
+
+// Route describes an endpoint once, for both the mux and the OpenAPI document.
+type Route struct {
+	Method  string
+	Path    string // ServeMux path, wildcards like {id} become path parameters
+	Tag     string
+	Summary string
+	Query   []QueryParam
+
+	// Request and Response are values of the JSON body types, e.g.
+	// RegisterUserRequest{}; nil means the request or response has no body.
+	Request  interface{}
+	Response interface{}
+	// Status is the success status, http.StatusOK when zero.
+	Status int
+	// Errors lists the error statuses the endpoint answers with besides 500.
+	Errors []int
+	// Auth requires a bearer access token; the handler finds the user with
+	// UserIDFromContext. Other routes accept a token but do not need one.
+	Auth bool
+}
+
+type QueryParam struct {
+	Name        string
+	Type        string // OpenAPI type, "string" when empty
+	Description string
+	Required    bool
+}
+
+// The query parameters read by ParsePagination and ParsePageRequest.
+var (
+	LimitParam       = QueryParam{Name: "limit", Type: "integer", Description: "Page size, 1 to 100 (default 10)"}
+	OffsetParam      = QueryParam{Name: "offset", Type: "integer", Description: "Number of items to skip, prefer cursor"}
+	CursorParam      = QueryParam{Name: "cursor", Description: "next_cursor or prev_cursor of a previous page"}
+	PaginationParams = []QueryParam{LimitParam, OffsetParam, CursorParam}
+)
+
+// Router is an http.ServeMux that remembers the routes registered on it and
+// authenticates the requests sent to them.
+type Router struct {
+	mux           *http.ServeMux
+	routes        []Route
+	authenticator Authenticator
+}
+
+func NewRouter(authenticator Authenticator) *Router {
+	return &Router{mux: http.NewServeMux(), authenticator: authenticator}
+}
+
+func (r *Router) Handle(route Route, handler http.HandlerFunc) {
+	if route.Status == 0 {
+		route.Status = http.StatusOK
+	}
+
+	r.mux.HandleFunc(route.Method+" "+route.Path, authenticate(r.authenticator, route.Auth, handler))
+	r.routes = append(r.routes, route)
+}
+
+// Routes returns the registered routes in registration order.
+func (r *Router) Routes() []Route {
+	return r.routes
+}
+
+func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mux.ServeHTTP(w, req)
+}
+
+// Pattern returns the ServeMux pattern a request would be routed to, or ""
+// when no route matches.
+func (r *Router) Pattern(req *http.Request) string {
+	_, pattern := r.mux.Handler(req)
+	return pattern
+}
@@ -0,0 +1,29 @@
+package shared
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+)
+
+// UnitOfWork runs a callback inside a single PostgreSQL transaction. The
+// factory builds the repositories the callback needs on top of the transaction,
+// so everything they write is committed or rolled back together.
+type UnitOfWork[R any] struct {
+	db      *gorm.DB
+	factory func(tx *gorm.DB) R
+}
+
+func NewUnitOfWork[R any](db *gorm.DB, factory func(tx *gorm.DB) R) *UnitOfWork[R] {
+	return &UnitOfWork[R]{
+		db:      db,
+		factory: factory,
+	}
+}
+
+// Do commits when fn returns nil and rolls back on error or panic.
+func (u *UnitOfWork[R]) Do(ctx context.Context, fn func(ctx context.Context, repos R) error) error {
+	return u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return fn(ctx, u.factory(tx))
+	})
+}
@@ -8,6 +8,15 @@ kafka-topics --bootstrap-server kafka:9092 --list
 # Create topics for each domain event
 echo "Creating Kafka topics..."
 
+# Movies domain topics
+echo "Creating movies domain topics..."
+kafka-topics --bootstrap-server kafka:9092 --create --if-not-exists --topic movies_import_completed --partitions 3 --replication-factor 1
+kafka-topics --bootstrap-server kafka:9092 --create --if-not-exists --topic movies_movies_merged --partitions 3 --replication-factor 1
+kafka-topics --bootstrap-server kafka:9092 --create --if-not-exists --topic movies_collection_created --partitions 3 --replication-factor 1
+kafka-topics --bootstrap-server kafka:9092 --create --if-not-exists --topic movies_collection_updated --partitions 3 --replication-factor 1
+kafka-topics --bootstrap-server kafka:9092 --create --if-not-exists --topic movies_collection_deleted --partitions 3 --replication-factor 1
+kafka-topics --bootstrap-server kafka:9092 --create --if-not-exists --topic movies_localizations_changed --partitions 3 --replication-factor 1
+
 # Watchlist domain topics
 echo "Creating watchlist domain topics..."
 kafka-topics --bootstrap-server kafka:9092 --create --if-not-exists --topic watchlist_movie_added --partitions 3 --replication-factor 1
@@ -28,6 +37,11 @@ kafka-topics --bootstrap-server kafka:9092 --create --if-not-exists --topic user
 kafka-topics --bootstrap-server kafka:9092 --create --if-not-exists --topic user_user_updated --partitions 3 --replication-factor 1
 kafka-topics --bootstrap-server kafka:9092 --create --if-not-exists --topic user_user_deleted --partitions 3 --replication-factor 1
 
+# People domain topics
+echo "Creating people domain topics..."
+kafka-topics --bootstrap-server kafka:9092 --create --if-not-exists --topic people_person_created --partitions 3 --replication-factor 1
+kafka-topics --bootstrap-server kafka:9092 --create --if-not-exists --topic people_movie_credits_changed --partitions 3 --replication-factor 1
+
 # List all created topics
 echo "Listing all topics:"
 kafka-topics --bootstrap-server kafka:9092 --list